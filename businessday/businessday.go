@@ -0,0 +1,159 @@
+// Package businessday provides a Calendar to work with business days, skipping weekends and holidays.
+package businessday
+
+import (
+	"errors"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// ErrNoBusinessDays is returned by New when the weekend contains every day of the week.
+var ErrNoBusinessDays = errors.New("weekend contains every day of the week")
+
+const (
+	// SaturdaySunday is the weekend used in most of the world.
+	SaturdaySunday Weekend = 1<<time.Saturday | 1<<time.Sunday
+	// FridaySaturday is the weekend used in many countries of the Middle East.
+	FridaySaturday Weekend = 1<<time.Friday | 1<<time.Saturday
+	// SundayOnly is a weekend of a single day, Sunday.
+	SundayOnly Weekend = 1 << time.Sunday
+
+	allDays Weekend = 1<<7 - 1
+
+	// maxSearchDays is the number of days searched for a business day before giving up, so holiday calendars
+	// covering open-ended ranges don't loop forever.
+	maxSearchDays = 10 * 366
+)
+
+type (
+	// Weekend is the set of days of the week that are not business days.
+	// The zero value is an empty weekend, every day of the week is a business day.
+	Weekend uint8
+
+	// Calendar decides which days are business days, i.e. days that are neither in its weekend nor a holiday.
+	// The zero value has no weekend and no holidays, so every day is a business day.
+	Calendar struct {
+		weekend  Weekend
+		holidays HolidayCalendar
+	}
+)
+
+// NewWeekend returns the Weekend made of the given days of the week.
+// Values outside [time.Sunday, time.Saturday] are not days of the week and are ignored.
+func NewWeekend(days ...time.Weekday) Weekend {
+	var w Weekend
+	for _, day := range days {
+		if isWeekday(day) {
+			w |= 1 << day
+		}
+	}
+
+	return w
+}
+
+// Contains reports whether the day of the week is part of the weekend.
+// It is false for values outside [time.Sunday, time.Saturday].
+func (w Weekend) Contains(day time.Weekday) bool {
+	return isWeekday(day) && w&(1<<day) != 0
+}
+
+// New Calendar with the given weekend and the union of the given holiday calendars.
+// Returns ErrNoBusinessDays if the weekend contains every day of the week.
+func New(weekend Weekend, holidays ...HolidayCalendar) (Calendar, error) {
+	if weekend&allDays == allDays {
+		return Calendar{}, ErrNoBusinessDays
+	}
+
+	c := Calendar{weekend: weekend}
+	switch len(holidays) {
+	case 0:
+	case 1:
+		c.holidays = holidays[0]
+	default:
+		c.holidays = Union(holidays...)
+	}
+
+	return c, nil
+}
+
+// AddBusinessDays returns the date n business days after ld, or before it if n is negative.
+// If n is zero, ld is returned unchanged, even if it's not a business day.
+// Returns false if no business day is found in the ten years after, or before, any of the steps.
+func (c Calendar) AddBusinessDays(ld localdate.LocalDate, n int) (localdate.LocalDate, bool) {
+	ok := true
+	for ; ok && n > 0; n-- {
+		ld, ok = c.NextBusinessDay(ld)
+	}
+
+	for ; ok && n < 0; n++ {
+		ld, ok = c.PreviousBusinessDay(ld)
+	}
+
+	return ld, ok
+}
+
+// BusinessDaysBetween returns the number of business days from start, inclusive, to end, exclusive.
+// The result is negative if end is before start.
+func (c Calendar) BusinessDaysBetween(start, end localdate.LocalDate) int {
+	if end.Before(start) {
+		return -c.BusinessDaysBetween(end, start)
+	}
+
+	n := 0
+	for ld := range start.DatesUntil(end) {
+		if c.IsBusinessDay(ld) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Holidays returns the holiday calendar of the Calendar, nil if it has none.
+func (c Calendar) Holidays() HolidayCalendar {
+	return c.holidays
+}
+
+// IsBusinessDay reports whether the date is neither a weekend day nor a holiday.
+func (c Calendar) IsBusinessDay(ld localdate.LocalDate) bool {
+	if c.weekend.Contains(ld.Weekday()) {
+		return false
+	}
+
+	return c.holidays == nil || !c.holidays.IsHoliday(ld)
+}
+
+// NextBusinessDay returns the first business day strictly after the date.
+// Returns false if there is no business day in the ten years after the date.
+func (c Calendar) NextBusinessDay(ld localdate.LocalDate) (localdate.LocalDate, bool) {
+	return c.search(ld, 1)
+}
+
+// PreviousBusinessDay returns the last business day strictly before the date.
+// Returns false if there is no business day in the ten years before the date.
+func (c Calendar) PreviousBusinessDay(ld localdate.LocalDate) (localdate.LocalDate, bool) {
+	return c.search(ld, -1)
+}
+
+// Weekend returns the weekend of the Calendar.
+func (c Calendar) Weekend() Weekend {
+	return c.weekend
+}
+
+// search returns the first business day found moving from the date by step days, at most maxSearchDays times.
+func (c Calendar) search(ld localdate.LocalDate, step int) (localdate.LocalDate, bool) {
+	for range maxSearchDays {
+		ld = ld.PlusDays(step)
+		if c.IsBusinessDay(ld) {
+			return ld, true
+		}
+	}
+
+	return localdate.LocalDate{}, false
+}
+
+// isWeekday reports whether the day is a valid day of the week, from time.Sunday to time.Saturday.
+func isWeekday(day time.Weekday) bool {
+	return day >= time.Sunday && day <= time.Saturday
+}
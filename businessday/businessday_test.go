@@ -0,0 +1,268 @@
+package businessday
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	if _, err := New(NewWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday,
+		time.Saturday)); !errors.Is(err, ErrNoBusinessDays) {
+		t.Errorf("New error = %v, want %v", err, ErrNoBusinessDays)
+	}
+
+	c, err := New(FridaySaturday)
+	if err != nil || c.Weekend() != FridaySaturday || c.Holidays() != nil {
+		t.Errorf("New = %+v, %v, want FridaySaturday calendar", c, err)
+	}
+}
+
+func TestWeekend(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		weekend Weekend
+		want    []time.Weekday
+	}{
+		"Saturday and Sunday": {weekend: SaturdaySunday, want: []time.Weekday{time.Saturday, time.Sunday}},
+		"Friday and Saturday": {weekend: FridaySaturday, want: []time.Weekday{time.Friday, time.Saturday}},
+		"Sunday only":         {weekend: SundayOnly, want: []time.Weekday{time.Sunday}},
+		"No weekend":          {},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := NewWeekend(test.want...); got != test.weekend {
+				t.Errorf("NewWeekend = %07b, want %07b", got, test.weekend)
+			}
+
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				want := false
+				for _, w := range test.want {
+					want = want || w == day
+				}
+
+				if got := test.weekend.Contains(day); got != want {
+					t.Errorf("Contains(%v) = %v, want %v", day, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	christmas := localdate.New(2024, time.December, 25)
+	calendar, _ := New(SaturdaySunday, Dates(christmas))
+
+	tests := map[string]struct {
+		calendar Calendar
+		ld       localdate.LocalDate
+		want     bool
+	}{
+		"Working day":             {calendar: calendar, ld: localdate.New(2024, time.December, 24), want: true},
+		"Holiday":                 {calendar: calendar, ld: christmas},
+		"Saturday":                {calendar: calendar, ld: localdate.New(2024, time.December, 28)},
+		"Sunday":                  {calendar: calendar, ld: localdate.New(2024, time.December, 29)},
+		"Zero value calendar":     {ld: localdate.New(2024, time.December, 29), want: true},
+		"Zero value, any holiday": {ld: christmas, want: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.calendar.IsBusinessDay(test.ld); got != test.want {
+				t.Errorf("IsBusinessDay(%v) = %v, want %v", test.ld, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWeekendInvalidDays(t *testing.T) {
+	t.Parallel()
+
+	if got := NewWeekend(-1, time.Sunday, 7, 64); got != SundayOnly {
+		t.Errorf("NewWeekend = %07b, want %07b", got, SundayOnly)
+	}
+
+	for _, day := range []time.Weekday{-1, 7, 64} {
+		if allDays.Contains(day) {
+			t.Errorf("Contains(%d) = true, want false", day)
+		}
+	}
+}
+
+func TestAddBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	christmas := localdate.New(2024, time.December, 25)
+	calendar, _ := New(SaturdaySunday, Dates(christmas, localdate.New(2024, time.December, 26)))
+	middleEast, _ := New(FridaySaturday)
+
+	tests := map[string]struct {
+		calendar Calendar
+		ld       localdate.LocalDate
+		n        int
+		want     localdate.LocalDate
+	}{
+		"Zero days on a holiday": {
+			calendar: calendar,
+			ld:       christmas,
+			want:     christmas,
+		},
+		"Over the holidays": {
+			calendar: calendar,
+			ld:       localdate.New(2024, time.December, 24),
+			n:        1,
+			want:     localdate.New(2024, time.December, 27),
+		},
+		"Over the weekend": {
+			calendar: calendar,
+			ld:       localdate.New(2024, time.December, 27),
+			n:        3,
+			want:     localdate.New(2025, time.January, 1),
+		},
+		"From a weekend day": {
+			calendar: calendar,
+			ld:       localdate.New(2024, time.December, 28),
+			n:        1,
+			want:     localdate.New(2024, time.December, 30),
+		},
+		"Backwards": {
+			calendar: calendar,
+			ld:       localdate.New(2024, time.December, 30),
+			n:        -2,
+			want:     localdate.New(2024, time.December, 24),
+		},
+		"Friday-Saturday weekend": {
+			calendar: middleEast,
+			ld:       localdate.New(2024, time.December, 26),
+			n:        1,
+			want:     localdate.New(2024, time.December, 29),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, ok := test.calendar.AddBusinessDays(test.ld, test.n); !ok || got != test.want {
+				t.Errorf("AddBusinessDays(%v, %d) = %v, %t, want %v, true", test.ld, test.n, got, ok, test.want)
+			}
+		})
+	}
+}
+
+func TestBusinessDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	calendar, _ := New(SaturdaySunday, Dates(localdate.New(2024, time.December, 25)))
+
+	tests := map[string]struct {
+		start, end localdate.LocalDate
+		want       int
+	}{
+		"Same day": {
+			start: localdate.New(2024, time.December, 23),
+			end:   localdate.New(2024, time.December, 23),
+		},
+		"End is exclusive": {
+			start: localdate.New(2024, time.December, 23),
+			end:   localdate.New(2024, time.December, 24),
+			want:  1,
+		},
+		"Two weeks with a holiday": {
+			start: localdate.New(2024, time.December, 23),
+			end:   localdate.New(2025, time.January, 6),
+			want:  9,
+		},
+		"End before start": {
+			start: localdate.New(2025, time.January, 6),
+			end:   localdate.New(2024, time.December, 23),
+			want:  -9,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := calendar.BusinessDaysBetween(test.start, test.end); got != test.want {
+				t.Errorf("BusinessDaysBetween(%v, %v) = %d, want %d", test.start, test.end, got, test.want)
+			}
+		})
+	}
+}
+
+func TestNextAndPreviousBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	calendar, _ := New(SundayOnly, Dates(localdate.New(2025, time.January, 1)))
+
+	tests := map[string]struct {
+		ld       localdate.LocalDate
+		next     localdate.LocalDate
+		previous localdate.LocalDate
+	}{
+		"Business day": {
+			ld:       localdate.New(2024, time.December, 27),
+			next:     localdate.New(2024, time.December, 28),
+			previous: localdate.New(2024, time.December, 26),
+		},
+		"Around Sunday": {
+			ld:       localdate.New(2024, time.December, 29),
+			next:     localdate.New(2024, time.December, 30),
+			previous: localdate.New(2024, time.December, 28),
+		},
+		"Around a holiday": {
+			ld:       localdate.New(2025, time.January, 1),
+			next:     localdate.New(2025, time.January, 2),
+			previous: localdate.New(2024, time.December, 31),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, ok := calendar.NextBusinessDay(test.ld); !ok || got != test.next {
+				t.Errorf("NextBusinessDay(%v) = %v, %t, want %v, true", test.ld, got, ok, test.next)
+			}
+
+			if got, ok := calendar.PreviousBusinessDay(test.ld); !ok || got != test.previous {
+				t.Errorf("PreviousBusinessDay(%v) = %v, %t, want %v, true", test.ld, got, ok, test.previous)
+			}
+		})
+	}
+}
+
+func TestNoBusinessDayFound(t *testing.T) {
+	t.Parallel()
+
+	start := localdate.New(2024, time.January, 1)
+	// Every day from 2024 onwards is a holiday.
+	calendar, _ := New(SaturdaySunday, HolidayCalendarFunc(func(ld localdate.LocalDate) bool {
+		return !ld.Before(start)
+	}))
+
+	if got, ok := calendar.NextBusinessDay(start); ok {
+		t.Errorf("NextBusinessDay = %v, true, want false", got)
+	}
+
+	if got, ok := calendar.AddBusinessDays(start.MinusDays(3), 2); ok {
+		t.Errorf("AddBusinessDays = %v, true, want false", got)
+	}
+
+	if got, ok := calendar.PreviousBusinessDay(start); !ok || got != localdate.New(2023, time.December, 29) {
+		t.Errorf("PreviousBusinessDay = %v, %t, want 2023-12-29, true", got, ok)
+	}
+}
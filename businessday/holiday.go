@@ -0,0 +1,64 @@
+package businessday
+
+import (
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var (
+	_ HolidayCalendar = HolidayCalendarFunc(nil)
+	_ HolidayCalendar = dates(nil)
+	_ HolidayCalendar = union(nil)
+)
+
+type (
+	// HolidayCalendar decides which dates are holidays, e.g. the public holidays of a country or the closing days of a
+	// market.
+	HolidayCalendar interface {
+		// IsHoliday reports whether the date is a holiday.
+		IsHoliday(ld localdate.LocalDate) bool
+	}
+
+	// HolidayCalendarFunc is an adapter to use an ordinary function as a HolidayCalendar.
+	HolidayCalendarFunc func(ld localdate.LocalDate) bool
+
+	dates map[localdate.LocalDate]struct{}
+
+	union []HolidayCalendar
+)
+
+// Dates returns a HolidayCalendar where the given dates are the holidays.
+func Dates(holidays ...localdate.LocalDate) HolidayCalendar {
+	d := make(dates, len(holidays))
+	for _, ld := range holidays {
+		d[ld] = struct{}{}
+	}
+
+	return d
+}
+
+// Union returns a HolidayCalendar where a date is a holiday if it's a holiday in any of the given calendars,
+// e.g. to combine the calendars of two markets.
+func Union(calendars ...HolidayCalendar) HolidayCalendar {
+	return append(union(nil), calendars...)
+}
+
+// IsHoliday calls f(ld).
+func (f HolidayCalendarFunc) IsHoliday(ld localdate.LocalDate) bool {
+	return f(ld)
+}
+
+func (d dates) IsHoliday(ld localdate.LocalDate) bool {
+	_, ok := d[ld]
+
+	return ok
+}
+
+func (u union) IsHoliday(ld localdate.LocalDate) bool {
+	for _, c := range u {
+		if c.IsHoliday(ld) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,48 @@
+package businessday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestHolidayCalendars(t *testing.T) {
+	t.Parallel()
+
+	newYear := localdate.New(2025, time.January, 1)
+	boxingDay := localdate.New(2024, time.December, 26)
+	goodFriday := localdate.New(2025, time.April, 18)
+
+	target := Dates(newYear, goodFriday)
+	uk := HolidayCalendarFunc(func(ld localdate.LocalDate) bool {
+		return ld == newYear || ld == boxingDay
+	})
+
+	tests := map[string]struct {
+		calendar HolidayCalendar
+		ld       localdate.LocalDate
+		want     bool
+	}{
+		"Dates, holiday":       {calendar: target, ld: goodFriday, want: true},
+		"Dates, not a holiday": {calendar: target, ld: boxingDay},
+		"Func, holiday":        {calendar: uk, ld: boxingDay, want: true},
+		"Func, not a holiday":  {calendar: uk, ld: goodFriday},
+		"Union, in both":       {calendar: Union(target, uk), ld: newYear, want: true},
+		"Union, in the first":  {calendar: Union(target, uk), ld: goodFriday, want: true},
+		"Union, in the second": {calendar: Union(target, uk), ld: boxingDay, want: true},
+		"Union, in none":       {calendar: Union(target, uk), ld: localdate.New(2025, time.January, 2)},
+		"Empty union":          {calendar: Union(), ld: newYear},
+		"Union of unions":      {calendar: Union(Union(target), Union(uk)), ld: boxingDay, want: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.calendar.IsHoliday(test.ld); got != test.want {
+				t.Errorf("IsHoliday(%v) = %v, want %v", test.ld, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Package chrono provides calendar systems other than the ISO one used by localdate, like the Japanese or the Hijri
+// calendars, to convert dates between them.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/chrono/package-summary.html.
+package chrono
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// ErrDateOutOfRange indicates that the date is outside the range supported by the chronology.
+var ErrDateOutOfRange = errors.New("chrono: date out of the range supported by the chronology")
+
+var _ ChronoDate = date{}
+
+type (
+	// Chronology is a calendar system, used to convert dates from and to the ISO calendar.
+	Chronology interface {
+		// Date returns the date in the chronology of the ISO date.
+		// Returns ErrDateOutOfRange if the chronology does not support the date.
+		Date(ld localdate.LocalDate) (ChronoDate, error)
+		// DateOf returns the date of the proleptic year, month and day in the chronology.
+		// Returns a *RangeError if the month or the day does not exist, or ErrDateOutOfRange if the chronology does not
+		// support the date.
+		DateOf(prolepticYear, month, day int) (ChronoDate, error)
+		// IsLeapYear reports whether the proleptic year is a leap year in the chronology.
+		IsLeapYear(prolepticYear int) bool
+		// Name of the chronology, e.g. "Japanese".
+		Name() string
+	}
+
+	// ChronoDate is a date in a Chronology.
+	// ChronoDates are comparable values, equal if they are the same date in the same chronology.
+	ChronoDate interface {
+		// Chronology returns the chronology of the date.
+		Chronology() Chronology
+		// Day returns the day of the month.
+		Day() int
+		// Era returns the name of the era of the date, e.g. "Heisei" or "AD".
+		Era() string
+		// LengthOfMonth returns the number of days of the month of the date.
+		LengthOfMonth() int
+		// Month returns the month of the year, starting at 1.
+		Month() int
+		// ProlepticYear returns the year counted continuously in the chronology, ignoring eras.
+		ProlepticYear() int
+		// String returns the chronology, era, year of era, month and day of the date, e.g. "Japanese Heisei 31-04-30".
+		String() string
+		// ToLocalDate returns the same date in the ISO calendar.
+		ToLocalDate() localdate.LocalDate
+		// YearOfEra returns the year within the era of the date.
+		YearOfEra() int
+	}
+
+	// RangeError is returned when a field of a date is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, e.g. "month" or "day".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
+
+	// calendar is the implementation of a Chronology.
+	calendar interface {
+		Chronology
+
+		// era returns the name of the era and the year of era of the date.
+		era(year, month, day int) (string, int)
+		// fromEpochDay returns the year, month and day of the number of days since 1970-01-01.
+		fromEpochDay(epochDay int64) (int, int, int)
+		// lengthOfMonth returns the number of days of the month.
+		lengthOfMonth(year, month int) int
+		// toEpochDay returns the number of days since 1970-01-01 of the date.
+		toEpochDay(year, month, day int) int64
+	}
+
+	date struct {
+		calendar calendar
+		year     int
+		month    int
+		day      int
+	}
+)
+
+func (d date) Chronology() Chronology {
+	return d.calendar
+}
+
+func (d date) Day() int {
+	return d.day
+}
+
+func (d date) Era() string {
+	era, _ := d.calendar.era(d.year, d.month, d.day)
+
+	return era
+}
+
+func (d date) LengthOfMonth() int {
+	return d.calendar.lengthOfMonth(d.year, d.month)
+}
+
+func (d date) Month() int {
+	return d.month
+}
+
+func (d date) ProlepticYear() int {
+	return d.year
+}
+
+func (d date) String() string {
+	era, yearOfEra := d.calendar.era(d.year, d.month, d.day)
+
+	b := make([]byte, 0, len("ThaiBuddhist BEFORE_ROC YYYY-MM-DD"))
+	b = append(b, d.calendar.Name()...)
+	b = append(b, ' ')
+	b = append(b, era...)
+	b = append(b, ' ')
+	b = codec.AppendInt(b, yearOfEra, 1)
+	b = append(b, '-')
+	b = codec.AppendInt(b, d.month, 2)
+	b = append(b, '-')
+	b = codec.AppendInt(b, d.day, 2)
+
+	return string(b)
+}
+
+func (d date) ToLocalDate() localdate.LocalDate {
+	return localdate.OfEpochDay(d.calendar.toEpochDay(d.year, d.month, d.day))
+}
+
+func (d date) YearOfEra() int {
+	_, yearOfEra := d.calendar.era(d.year, d.month, d.day)
+
+	return yearOfEra
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("chrono: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
+
+// dateOf returns the date of the calendar, validating its month and day.
+func dateOf(c calendar, year, month, day int) (date, error) {
+	if month < 1 || month > 12 {
+		return date{}, &RangeError{Field: "month", Value: month, Min: 1, Max: 12}
+	}
+
+	if maxDay := c.lengthOfMonth(year, month); day < 1 || day > maxDay {
+		return date{}, &RangeError{Field: "day", Value: day, Min: 1, Max: maxDay}
+	}
+
+	return date{calendar: c, year: year, month: month, day: day}, nil
+}
+
+// fromLocalDate returns the date of the calendar of the ISO date.
+func fromLocalDate(c calendar, ld localdate.LocalDate) date {
+	year, month, day := c.fromEpochDay(ld.ToEpochDay())
+
+	return date{calendar: c, year: year, month: month, day: day}
+}
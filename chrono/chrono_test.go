@@ -0,0 +1,136 @@
+package chrono
+
+import (
+	"testing"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// chronoTest is a date in a chronology, with its expected fields.
+type chronoTest struct {
+	ld            localdate.LocalDate
+	prolepticYear int
+	month         int
+	day           int
+	era           string
+	yearOfEra     int
+	str           string
+}
+
+// assertChronoDate converts the ISO date to the chronology and back, checking the fields of the ChronoDate.
+func assertChronoDate(t *testing.T, chronology Chronology, test chronoTest) {
+	t.Helper()
+
+	got, err := chronology.Date(test.ld)
+	if err != nil {
+		t.Fatalf("Date(%v) error = %v", test.ld, err)
+	}
+
+	if got.ProlepticYear() != test.prolepticYear || got.Month() != test.month || got.Day() != test.day {
+		t.Errorf("Date(%v) = %d-%d-%d, want %d-%d-%d", test.ld, got.ProlepticYear(), got.Month(), got.Day(),
+			test.prolepticYear, test.month, test.day)
+	}
+
+	if got.Era() != test.era || got.YearOfEra() != test.yearOfEra {
+		t.Errorf("Era, YearOfEra = %s, %d, want %s, %d", got.Era(), got.YearOfEra(), test.era, test.yearOfEra)
+	}
+
+	if got.String() != test.str {
+		t.Errorf("String = %q, want %q", got.String(), test.str)
+	}
+
+	if got.ToLocalDate() != test.ld {
+		t.Errorf("ToLocalDate = %v, want %v", got.ToLocalDate(), test.ld)
+	}
+
+	if got.Chronology() != chronology {
+		t.Errorf("Chronology = %v, want %v", got.Chronology(), chronology)
+	}
+
+	of, err := chronology.DateOf(test.prolepticYear, test.month, test.day)
+	if err != nil || of != got {
+		t.Errorf("DateOf = %v, %v, want %v", of, err, got)
+	}
+}
+
+func TestDateOfError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		chronology                Chronology
+		prolepticYear, month, day int
+		want                      error
+	}{
+		"Month 13": {
+			chronology:    Julian{},
+			prolepticYear: 2024, month: 13, day: 1,
+			want: &RangeError{Field: "month", Value: 13, Min: 1, Max: 12},
+		},
+		"Day 0": {
+			chronology:    ThaiBuddhist{},
+			prolepticYear: 2567, month: 1, day: 0,
+			want: &RangeError{Field: "day", Value: 0, Min: 1, Max: 31},
+		},
+		"Julian leap day that is not Gregorian": {
+			chronology:    Minguo{},
+			prolepticYear: -11, month: 2, day: 29,
+			want: &RangeError{Field: "day", Value: 29, Min: 1, Max: 28},
+		},
+		"Hijri 30th of a short month": {
+			chronology:    Hijri{},
+			prolepticYear: 1445, month: 2, day: 30,
+			want: &RangeError{Field: "day", Value: 30, Min: 1, Max: 29},
+		},
+		"Hijri before 1 AH": {
+			chronology:    Hijri{},
+			prolepticYear: 0, month: 1, day: 1,
+			want: ErrDateOutOfRange,
+		},
+		"Japanese before 1873": {
+			chronology:    Japanese{},
+			prolepticYear: 1872, month: 12, day: 31,
+			want: ErrDateOutOfRange,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.chronology.DateOf(test.prolepticYear, test.month, test.day)
+
+			if err == nil || err.Error() != test.want.Error() {
+				t.Errorf("DateOf error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestLeapYears(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		chronology    Chronology
+		prolepticYear int
+		want          bool
+	}{
+		"Julian century":          {chronology: Julian{}, prolepticYear: 1900, want: true},
+		"Julian 1 BC":             {chronology: Julian{}, prolepticYear: 0, want: true},
+		"Julian common year":      {chronology: Julian{}, prolepticYear: 2023},
+		"ThaiBuddhist 2567":       {chronology: ThaiBuddhist{}, prolepticYear: 2567, want: true},
+		"Minguo 113":              {chronology: Minguo{}, prolepticYear: 113, want: true},
+		"Japanese 1900":           {chronology: Japanese{}, prolepticYear: 1900},
+		"Hijri 2nd year of cycle": {chronology: Hijri{}, prolepticYear: 1442, want: true},
+		"Hijri common year":       {chronology: Hijri{}, prolepticYear: 1444},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.chronology.IsLeapYear(test.prolepticYear); got != test.want {
+				t.Errorf("IsLeapYear(%d) = %v, want %v", test.prolepticYear, got, test.want)
+			}
+		})
+	}
+}
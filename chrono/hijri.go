@@ -0,0 +1,72 @@
+package chrono
+
+import (
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Chronology = Hijri{}
+
+// Hijri is the tabular Islamic calendar, an arithmetical approximation of the lunar Hijri calendar, with 12 months of
+// alternately 30 and 29 days, and 11 leap years in each cycle of 30 years, when the 12th month has 30 days.
+// It uses the leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of the Kuwaiti algorithm, and the civil epoch,
+// 1 Muharram 1 AH being July 16th, 622 in the Julian calendar.
+// The actual calendar, based on the observation of the moon, may differ by a day or two.
+// Only dates since 1 AH are supported.
+type Hijri struct{}
+
+func (c Hijri) Date(ld localdate.LocalDate) (ChronoDate, error) {
+	if ld.ToEpochDay() < hijriEpochDay {
+		return nil, ErrDateOutOfRange
+	}
+
+	return fromLocalDate(c, ld), nil
+}
+
+func (c Hijri) DateOf(prolepticYear, month, day int) (ChronoDate, error) {
+	if prolepticYear < 1 {
+		return nil, ErrDateOutOfRange
+	}
+
+	return dateOf(c, prolepticYear, month, day)
+}
+
+func (c Hijri) IsLeapYear(prolepticYear int) bool {
+	return (14+11*(prolepticYear%30+30))%30 < 11
+}
+
+func (c Hijri) Name() string {
+	return "Hijri"
+}
+
+func (c Hijri) era(year, _, _ int) (string, int) {
+	return "AH", year
+}
+
+func (c Hijri) fromEpochDay(epochDay int64) (int, int, int) {
+	year := int(arith.FloorDiv(30*(epochDay-hijriEpochDay)+10646, 10631))
+
+	month := 1
+	for month < 12 && c.toEpochDay(year, month+1, 1) <= epochDay {
+		month++
+	}
+
+	return year, month, int(epochDay-c.toEpochDay(year, month, 1)) + 1
+}
+
+func (c Hijri) lengthOfMonth(year, month int) int {
+	if month%2 == 1 || (month == 12 && c.IsLeapYear(year)) {
+		return 30
+	}
+
+	return 29
+}
+
+func (c Hijri) toEpochDay(year, month, day int) int64 {
+	y := int64(year)
+
+	return hijriEpochDay + (y-1)*354 + arith.FloorDiv(3+11*y, 30) + int64(59*(month-1)+1)/2 + int64(day) - 1
+}
+
+// hijriEpochDay is the epoch day of 1 Muharram 1 AH, July 19th, 622 in the ISO calendar.
+const hijriEpochDay = 1948440 - 2440588
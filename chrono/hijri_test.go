@@ -0,0 +1,70 @@
+package chrono
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestHijri(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chronoTest{
+		"Epoch": {
+			ld:            localdate.New(622, time.July, 19),
+			prolepticYear: 1, month: 1, day: 1,
+			era: "AH", yearOfEra: 1,
+			str: "Hijri AH 1-01-01",
+		},
+		"Ramadan": {
+			ld:            localdate.New(2024, time.March, 11),
+			prolepticYear: 1445, month: 9, day: 1,
+			era: "AH", yearOfEra: 1445,
+			str: "Hijri AH 1445-09-01",
+		},
+		"Eid al-Fitr": {
+			ld:            localdate.New(2024, time.April, 10),
+			prolepticYear: 1445, month: 10, day: 1,
+			era: "AH", yearOfEra: 1445,
+			str: "Hijri AH 1445-10-01",
+		},
+		"Leap day": {
+			ld:            localdate.New(2024, time.July, 7),
+			prolepticYear: 1445, month: 12, day: 30,
+			era: "AH", yearOfEra: 1445,
+			str: "Hijri AH 1445-12-30",
+		},
+		"Year 2000": {
+			ld:            localdate.New(2000, time.January, 1),
+			prolepticYear: 1420, month: 9, day: 24,
+			era: "AH", yearOfEra: 1420,
+			str: "Hijri AH 1420-09-24",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertChronoDate(t, Hijri{}, test)
+		})
+	}
+
+	if _, err := (Hijri{}).Date(localdate.New(622, time.July, 18)); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("Date error = %v, want %v", err, ErrDateOutOfRange)
+	}
+}
+
+func TestHijriRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	start := localdate.New(2020, time.January, 1)
+	for ld := range start.DatesUntil(start.PlusYears(31)) {
+		d, err := Hijri{}.Date(ld)
+		if err != nil || d.ToLocalDate() != ld || d.Day() > d.LengthOfMonth() {
+			t.Fatalf("Date(%v) = %v, %v, round trip %v", ld, d, err, d.ToLocalDate())
+		}
+	}
+}
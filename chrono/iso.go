@@ -0,0 +1,40 @@
+package chrono
+
+import (
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// isoYears implements the calendars that have the same months and days as the ISO one, only numbering the years
+// differently, offset being the difference between their proleptic year and the ISO year.
+type isoYears struct {
+	offset int
+}
+
+func (c isoYears) fromEpochDay(epochDay int64) (int, int, int) {
+	ld := localdate.OfEpochDay(epochDay)
+
+	return ld.Year() + c.offset, int(ld.Month()), ld.Day()
+}
+
+func (c isoYears) isLeapYear(prolepticYear int) bool {
+	return localdate.New(prolepticYear-c.offset, time.January, 1).IsLeapYear()
+}
+
+func (c isoYears) lengthOfMonth(year, month int) int {
+	return localdate.New(year-c.offset, time.Month(month), 1).LengthOfMonth()
+}
+
+func (c isoYears) toEpochDay(year, month, day int) int64 {
+	return localdate.New(year-c.offset, time.Month(month), day).ToEpochDay()
+}
+
+// eraOrBefore returns the era if the year is positive, or the era before it, counting the years backwards.
+func eraOrBefore(year int, era, before string) (string, int) {
+	if year >= 1 {
+		return era, year
+	}
+
+	return before, 1 - year
+}
@@ -0,0 +1,116 @@
+package chrono
+
+import (
+	"errors"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// ErrUnknownEra indicates that the era is not one of the eras of the chronology.
+var ErrUnknownEra = errors.New("chrono: unknown era")
+
+var _ Chronology = Japanese{}
+
+// japaneseEras are the eras of the Japanese calendar since the adoption of the Gregorian calendar, latest first.
+//
+//nolint:gochecknoglobals // table of eras, like a constant.
+var japaneseEras = [...]struct {
+	name  string
+	start localdate.LocalDate
+}{
+	{name: "Reiwa", start: localdate.New(2019, time.May, 1)},
+	{name: "Heisei", start: localdate.New(1989, time.January, 8)},
+	{name: "Showa", start: localdate.New(1926, time.December, 25)},
+	{name: "Taisho", start: localdate.New(1912, time.July, 30)},
+	{name: "Meiji", start: localdate.New(1868, time.January, 1)},
+}
+
+// Japanese is the Japanese imperial calendar, with the same months and days as the ISO calendar, and the years
+// counted from the start of the era of each emperor, e.g. 2019-04-30 is Heisei 31-04-30 and 2019-05-01 is
+// Reiwa 1-05-01.
+// Only dates since 1873-01-01, when Japan adopted the Gregorian calendar, are supported.
+type Japanese struct{}
+
+func (c Japanese) Date(ld localdate.LocalDate) (ChronoDate, error) {
+	if ld.Before(japaneseMinDate()) {
+		return nil, ErrDateOutOfRange
+	}
+
+	return fromLocalDate(c, ld), nil
+}
+
+func (c Japanese) DateOf(prolepticYear, month, day int) (ChronoDate, error) {
+	d, err := dateOf(c, prolepticYear, month, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.ToLocalDate().Before(japaneseMinDate()) {
+		return nil, ErrDateOutOfRange
+	}
+
+	return d, nil
+}
+
+// DateOfEra returns the date of the year of the era, month and day, e.g. DateOfEra("Heisei", 31, 4, 30).
+// Returns ErrUnknownEra if the era does not exist, a *RangeError if the month or the day does not exist, or
+// ErrDateOutOfRange if the date is not in the era.
+func (c Japanese) DateOfEra(era string, yearOfEra, month, day int) (ChronoDate, error) {
+	for _, e := range japaneseEras {
+		if e.name != era {
+			continue
+		}
+
+		d, err := c.DateOf(e.start.Year()+yearOfEra-1, month, day)
+		if err != nil {
+			return nil, err
+		}
+
+		if d.Era() != era {
+			return nil, ErrDateOutOfRange
+		}
+
+		return d, nil
+	}
+
+	return nil, ErrUnknownEra
+}
+
+func (c Japanese) IsLeapYear(prolepticYear int) bool {
+	return isoYears{}.isLeapYear(prolepticYear)
+}
+
+func (c Japanese) Name() string {
+	return "Japanese"
+}
+
+func (c Japanese) era(year, month, day int) (string, int) {
+	ld := localdate.New(year, time.Month(month), day)
+	for _, e := range japaneseEras {
+		if !ld.Before(e.start) {
+			return e.name, year - e.start.Year() + 1
+		}
+	}
+
+	last := japaneseEras[len(japaneseEras)-1]
+
+	return last.name, year - last.start.Year() + 1
+}
+
+func (c Japanese) fromEpochDay(epochDay int64) (int, int, int) {
+	return isoYears{}.fromEpochDay(epochDay)
+}
+
+func (c Japanese) lengthOfMonth(year, month int) int {
+	return isoYears{}.lengthOfMonth(year, month)
+}
+
+func (c Japanese) toEpochDay(year, month, day int) int64 {
+	return isoYears{}.toEpochDay(year, month, day)
+}
+
+// japaneseMinDate returns the first date supported by the Japanese calendar.
+func japaneseMinDate() localdate.LocalDate {
+	return localdate.New(1873, time.January, 1)
+}
@@ -0,0 +1,105 @@
+package chrono
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestJapanese(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chronoTest{
+		"Last day of Heisei": {
+			ld:            localdate.New(2019, time.April, 30),
+			prolepticYear: 2019, month: 4, day: 30,
+			era: "Heisei", yearOfEra: 31,
+			str: "Japanese Heisei 31-04-30",
+		},
+		"First day of Reiwa": {
+			ld:            localdate.New(2019, time.May, 1),
+			prolepticYear: 2019, month: 5, day: 1,
+			era: "Reiwa", yearOfEra: 1,
+			str: "Japanese Reiwa 1-05-01",
+		},
+		"Last day of Showa": {
+			ld:            localdate.New(1989, time.January, 7),
+			prolepticYear: 1989, month: 1, day: 7,
+			era: "Showa", yearOfEra: 64,
+			str: "Japanese Showa 64-01-07",
+		},
+		"Taisho": {
+			ld:            localdate.New(1912, time.July, 30),
+			prolepticYear: 1912, month: 7, day: 30,
+			era: "Taisho", yearOfEra: 1,
+			str: "Japanese Taisho 1-07-30",
+		},
+		"First supported day": {
+			ld:            localdate.New(1873, time.January, 1),
+			prolepticYear: 1873, month: 1, day: 1,
+			era: "Meiji", yearOfEra: 6,
+			str: "Japanese Meiji 6-01-01",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertChronoDate(t, Japanese{}, test)
+		})
+	}
+
+	if _, err := (Japanese{}).Date(localdate.New(1872, time.December, 31)); !errors.Is(err, ErrDateOutOfRange) {
+		t.Errorf("Date error = %v, want %v", err, ErrDateOutOfRange)
+	}
+}
+
+func TestJapaneseDateOfEra(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		era                   string
+		yearOfEra, month, day int
+		want                  localdate.LocalDate
+		wantErr               error
+	}{
+		"Heisei": {era: "Heisei", yearOfEra: 31, month: 4, day: 30, want: localdate.New(2019, time.April, 30)},
+		"Reiwa":  {era: "Reiwa", yearOfEra: 6, month: 2, day: 29, want: localdate.New(2024, time.February, 29)},
+		"After the end of the era": {
+			era: "Heisei", yearOfEra: 31, month: 5, day: 1,
+			wantErr: ErrDateOutOfRange,
+		},
+		"Before the start of the era": {
+			era: "Reiwa", yearOfEra: 1, month: 4, day: 30,
+			wantErr: ErrDateOutOfRange,
+		},
+		"Unknown era": {era: "Edo", yearOfEra: 1, month: 1, day: 1, wantErr: ErrUnknownEra},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Japanese{}.DateOfEra(test.era, test.yearOfEra, test.month, test.day)
+			if test.wantErr != nil {
+				if !errors.Is(err, test.wantErr) {
+					t.Errorf("DateOfEra error = %v, want %v", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil || got.ToLocalDate() != test.want {
+				t.Errorf("DateOfEra = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+
+	var rangeErr *RangeError
+	if _, err := (Japanese{}).DateOfEra("Reiwa", 5, 2, 29); !errors.As(err, &rangeErr) {
+		t.Errorf("DateOfEra error = %v, want *RangeError", err)
+	}
+}
@@ -0,0 +1,65 @@
+package chrono
+
+import (
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Chronology = Julian{}
+
+// Julian is the proleptic Julian calendar, which has a leap year every four years, without exceptions.
+// The year 0 is 1 BC, and the year -1 is 2 BC.
+type Julian struct{}
+
+func (c Julian) Date(ld localdate.LocalDate) (ChronoDate, error) {
+	return fromLocalDate(c, ld), nil
+}
+
+func (c Julian) DateOf(prolepticYear, month, day int) (ChronoDate, error) {
+	return dateOf(c, prolepticYear, month, day)
+}
+
+func (c Julian) IsLeapYear(prolepticYear int) bool {
+	return prolepticYear%4 == 0
+}
+
+func (c Julian) Name() string {
+	return "Julian"
+}
+
+func (c Julian) era(year, _, _ int) (string, int) {
+	return eraOrBefore(year, "AD", "BC")
+}
+
+func (c Julian) fromEpochDay(epochDay int64) (int, int, int) {
+	// Days since March 1st of the year -4800, so the leap day is the last day of a year.
+	days := epochDay + julianEpochShift
+	cycle := arith.FloorDiv(4*days+3, 1461)
+	dayOfYear := days - 1461*cycle/4
+	m := (5*dayOfYear + 2) / 153
+
+	day := dayOfYear - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := cycle - 4800 + m/10
+
+	return int(year), int(month), int(day)
+}
+
+func (c Julian) lengthOfMonth(year, month int) int {
+	if month == 2 && c.IsLeapYear(year) {
+		return 29
+	}
+
+	return isoYears{}.lengthOfMonth(2001, month)
+}
+
+func (c Julian) toEpochDay(year, month, day int) int64 {
+	a := int64(14-month) / 12
+	y := int64(year) + 4800 - a
+	m := int64(month) + 12*a - 3
+
+	return int64(day) + (153*m+2)/5 + 365*y + arith.FloorDiv(y, 4) - julianEpochShift - 1
+}
+
+// julianEpochShift is the number of days from March 1st of the Julian year -4800 to 1970-01-01.
+const julianEpochShift = 2440588 + 32082
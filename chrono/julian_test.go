@@ -0,0 +1,53 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestJulian(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chronoTest{
+		"Today": {
+			ld:            localdate.New(2024, time.March, 15),
+			prolepticYear: 2024, month: 3, day: 2,
+			era: "AD", yearOfEra: 2024,
+			str: "Julian AD 2024-03-02",
+		},
+		"Gregorian reform": {
+			ld:            localdate.New(1582, time.October, 15),
+			prolepticYear: 1582, month: 10, day: 5,
+			era: "AD", yearOfEra: 1582,
+			str: "Julian AD 1582-10-05",
+		},
+		"Leap day of a Gregorian common year": {
+			ld:            localdate.New(1900, time.March, 13),
+			prolepticYear: 1900, month: 2, day: 29,
+			era: "AD", yearOfEra: 1900,
+			str: "Julian AD 1900-02-29",
+		},
+		"Hijri epoch": {
+			ld:            localdate.New(622, time.July, 19),
+			prolepticYear: 622, month: 7, day: 16,
+			era: "AD", yearOfEra: 622,
+			str: "Julian AD 622-07-16",
+		},
+		"Before Christ": {
+			ld:            localdate.New(-43, time.March, 13),
+			prolepticYear: -43, month: 3, day: 15,
+			era: "BC", yearOfEra: 44,
+			str: "Julian BC 44-03-15",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertChronoDate(t, Julian{}, test)
+		})
+	}
+}
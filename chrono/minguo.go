@@ -0,0 +1,47 @@
+package chrono
+
+import (
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Chronology = Minguo{}
+
+// Minguo is the calendar of the Republic of China (Taiwan), with the same months and days as the ISO calendar, and the
+// years counted from 1912, so 2024 is the year 113 of the Republic of China (ROC) era.
+type Minguo struct{}
+
+func (c Minguo) Date(ld localdate.LocalDate) (ChronoDate, error) {
+	return fromLocalDate(c, ld), nil
+}
+
+func (c Minguo) DateOf(prolepticYear, month, day int) (ChronoDate, error) {
+	return dateOf(c, prolepticYear, month, day)
+}
+
+func (c Minguo) IsLeapYear(prolepticYear int) bool {
+	return c.years().isLeapYear(prolepticYear)
+}
+
+func (c Minguo) Name() string {
+	return "Minguo"
+}
+
+func (c Minguo) era(year, _, _ int) (string, int) {
+	return eraOrBefore(year, "ROC", "BEFORE_ROC")
+}
+
+func (c Minguo) fromEpochDay(epochDay int64) (int, int, int) {
+	return c.years().fromEpochDay(epochDay)
+}
+
+func (c Minguo) lengthOfMonth(year, month int) int {
+	return c.years().lengthOfMonth(year, month)
+}
+
+func (c Minguo) toEpochDay(year, month, day int) int64 {
+	return c.years().toEpochDay(year, month, day)
+}
+
+func (c Minguo) years() isoYears {
+	return isoYears{offset: -1911}
+}
@@ -0,0 +1,41 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestMinguo(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chronoTest{
+		"Republic of China era": {
+			ld:            localdate.New(2024, time.March, 15),
+			prolepticYear: 113, month: 3, day: 15,
+			era: "ROC", yearOfEra: 113,
+			str: "Minguo ROC 113-03-15",
+		},
+		"First day": {
+			ld:            localdate.New(1912, time.January, 1),
+			prolepticYear: 1, month: 1, day: 1,
+			era: "ROC", yearOfEra: 1,
+			str: "Minguo ROC 1-01-01",
+		},
+		"Before the Republic of China": {
+			ld:            localdate.New(1911, time.December, 31),
+			prolepticYear: 0, month: 12, day: 31,
+			era: "BEFORE_ROC", yearOfEra: 1,
+			str: "Minguo BEFORE_ROC 1-12-31",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertChronoDate(t, Minguo{}, test)
+		})
+	}
+}
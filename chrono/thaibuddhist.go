@@ -0,0 +1,47 @@
+package chrono
+
+import (
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Chronology = ThaiBuddhist{}
+
+// ThaiBuddhist is the Thai solar calendar, with the same months and days as the ISO calendar, and the years counted
+// from 543 BC, so 2024 is the year 2567 of the Buddhist Era (BE).
+type ThaiBuddhist struct{}
+
+func (c ThaiBuddhist) Date(ld localdate.LocalDate) (ChronoDate, error) {
+	return fromLocalDate(c, ld), nil
+}
+
+func (c ThaiBuddhist) DateOf(prolepticYear, month, day int) (ChronoDate, error) {
+	return dateOf(c, prolepticYear, month, day)
+}
+
+func (c ThaiBuddhist) IsLeapYear(prolepticYear int) bool {
+	return c.years().isLeapYear(prolepticYear)
+}
+
+func (c ThaiBuddhist) Name() string {
+	return "ThaiBuddhist"
+}
+
+func (c ThaiBuddhist) era(year, _, _ int) (string, int) {
+	return eraOrBefore(year, "BE", "BEFORE_BE")
+}
+
+func (c ThaiBuddhist) fromEpochDay(epochDay int64) (int, int, int) {
+	return c.years().fromEpochDay(epochDay)
+}
+
+func (c ThaiBuddhist) lengthOfMonth(year, month int) int {
+	return c.years().lengthOfMonth(year, month)
+}
+
+func (c ThaiBuddhist) toEpochDay(year, month, day int) int64 {
+	return c.years().toEpochDay(year, month, day)
+}
+
+func (c ThaiBuddhist) years() isoYears {
+	return isoYears{offset: 543}
+}
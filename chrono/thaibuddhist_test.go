@@ -0,0 +1,35 @@
+package chrono
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestThaiBuddhist(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]chronoTest{
+		"Buddhist era": {
+			ld:            localdate.New(2024, time.March, 15),
+			prolepticYear: 2567, month: 3, day: 15,
+			era: "BE", yearOfEra: 2567,
+			str: "ThaiBuddhist BE 2567-03-15",
+		},
+		"Before the Buddhist era": {
+			ld:            localdate.New(-543, time.December, 31),
+			prolepticYear: 0, month: 12, day: 31,
+			era: "BEFORE_BE", yearOfEra: 1,
+			str: "ThaiBuddhist BEFORE_BE 1-12-31",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assertChronoDate(t, ThaiBuddhist{}, test)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+// Package clock provides Clock, giving access to the current instant, so the code depending on it can be tested.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/Clock.html.
+package clock
+
+import (
+	"time"
+)
+
+var (
+	_ TimerClock = systemClock{}
+	_ Clock      = fixedClock{}
+	_ Clock      = offsetClock{}
+)
+
+type (
+	// Clock provides the current instant.
+	// Use System in production, and Fixed or Offset in tests, instead of calling time.Now directly.
+	Clock interface {
+		// Now returns the current instant.
+		Now() time.Time
+	}
+
+	// TimerClock is a Clock that can also wait, with the same semantics as the functions of the time package.
+	// Use System in production, and a FakeClock in tests to fire the timers by advancing the time manually.
+	TimerClock interface {
+		Clock
+
+		// After waits for the duration to elapse and then sends the current time on the returned channel.
+		After(d time.Duration) <-chan time.Time
+		// NewTicker returns a Ticker sending the time on its channel after each period d.
+		// Panics if d is not positive.
+		NewTicker(d time.Duration) Ticker
+		// NewTimer returns a Timer sending the time on its channel after at least the duration d.
+		NewTimer(d time.Duration) Timer
+		// Sleep pauses the current goroutine for at least the duration d.
+		Sleep(d time.Duration)
+	}
+
+	// Timer is a single event, as time.Timer.
+	Timer interface {
+		// C returns the channel on which the time is delivered.
+		C() <-chan time.Time
+		// Reset changes the timer to expire after the duration d, returning whether the timer was active.
+		Reset(d time.Duration) bool
+		// Stop prevents the timer from firing, returning whether the timer was active.
+		Stop() bool
+	}
+
+	// Ticker delivers ticks at intervals, as time.Ticker.
+	Ticker interface {
+		// C returns the channel on which the ticks are delivered.
+		C() <-chan time.Time
+		// Reset stops the ticker and resets its period to d, the next tick arriving after d.
+		Reset(d time.Duration)
+		// Stop turns off the ticker, no more ticks being sent.
+		Stop()
+	}
+
+	systemClock struct{}
+
+	systemTimer struct {
+		*time.Timer
+	}
+
+	systemTicker struct {
+		*time.Ticker
+	}
+
+	fixedClock struct {
+		t time.Time
+	}
+
+	offsetClock struct {
+		base   Clock
+		offset time.Duration
+	}
+)
+
+// System returns the Clock of the system, whose methods are the functions of the time package, e.g. time.Now.
+func System() TimerClock {
+	return systemClock{}
+}
+
+// Fixed returns a Clock that always returns t.
+func Fixed(t time.Time) Clock {
+	return fixedClock{t: t}
+}
+
+// Offset returns a Clock that returns the instants of base shifted by offset, e.g. to simulate a time in the future.
+func Offset(base Clock, offset time.Duration) Clock {
+	return offsetClock{base: base, offset: offset}
+}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+func (systemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.t
+}
+
+func (c offsetClock) Now() time.Time {
+	return c.base.Now().Add(c.offset)
+}
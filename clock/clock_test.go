@@ -0,0 +1,82 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSystem(t *testing.T) {
+	t.Parallel()
+
+	before := time.Now()
+	got := System().Now()
+	after := time.Now()
+
+	if got.Before(before) || got.After(after) {
+		t.Errorf("Now = %v, want between %v and %v", got, before, after)
+	}
+}
+
+func TestFixed(t *testing.T) {
+	t.Parallel()
+
+	want := time.Date(2024, time.March, 5, 18, 45, 0, 0, time.UTC)
+	c := Fixed(want)
+
+	for range 2 {
+		if got := c.Now(); !got.Equal(want) {
+			t.Errorf("Now = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+
+	base := time.Date(2024, time.March, 5, 18, 45, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		offset time.Duration
+		want   time.Time
+	}{
+		"Zero offset":     {offset: 0, want: base},
+		"Future":          {offset: 36 * time.Hour, want: time.Date(2024, time.March, 7, 6, 45, 0, 0, time.UTC)},
+		"Past":            {offset: -time.Minute, want: time.Date(2024, time.March, 5, 18, 44, 0, 0, time.UTC)},
+		"Crossing a year": {offset: 300 * 24 * time.Hour, want: time.Date(2024, time.December, 30, 18, 45, 0, 0, time.UTC)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Offset(Fixed(base), test.offset).Now(); !got.Equal(test.want) {
+				t.Errorf("Now = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestSystemTimers(t *testing.T) {
+	t.Parallel()
+
+	c := System()
+
+	timer := c.NewTimer(time.Millisecond)
+	<-timer.C()
+
+	if timer.Stop() {
+		t.Error("Stop of a fired timer = true, want false")
+	}
+
+	ticker := c.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Stop()
+
+	before := time.Now()
+	c.Sleep(time.Millisecond)
+	<-c.After(time.Millisecond)
+
+	if elapsed := time.Since(before); elapsed < 2*time.Millisecond {
+		t.Errorf("Sleep and After took %v, want at least 2ms", elapsed)
+	}
+}
@@ -0,0 +1,251 @@
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+var (
+	_ TimerClock = new(FakeClock)
+	_ Timer      = fakeTimer{}
+	_ Ticker     = fakeTicker{}
+)
+
+type (
+	// FakeClock is a TimerClock whose time only changes when it is advanced manually, firing the timers, tickers and
+	// sleeps that expire deterministically, so the code depending on them can be tested without real waits.
+	// The zero value is a FakeClock at the zero time.Time, and it is safe for concurrent use.
+	FakeClock struct {
+		mu      sync.Mutex
+		now     time.Time
+		waiters []*waiter
+		// added is closed, and replaced, each time a waiter is added, to wake up BlockUntil.
+		added chan struct{}
+	}
+
+	// waiter is a pending After, Sleep, Timer or Ticker of a FakeClock.
+	waiter struct {
+		until time.Time
+		// period is the interval of a ticker, 0 for the rest.
+		period time.Duration
+		c      chan time.Time
+	}
+
+	fakeTimer struct {
+		clock  *FakeClock
+		waiter *waiter
+	}
+
+	fakeTicker struct {
+		clock  *FakeClock
+		waiter *waiter
+	}
+)
+
+// NewFakeClock returns a FakeClock at t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Advance moves the time forward by d, firing, in order, the timers, tickers and sleeps that expire.
+// Each one receives the time it expired at, and a ticker that expires several times only receives the first tick,
+// as a time.Ticker drops the ticks of slow receivers.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	slices.SortStableFunc(c.waiters, func(a, b *waiter) int {
+		return a.until.Compare(b.until)
+	})
+
+	c.waiters = slices.DeleteFunc(c.waiters, func(w *waiter) bool {
+		if w.until.After(c.now) {
+			return false
+		}
+
+		w.fire()
+
+		if w.period == 0 {
+			return true
+		}
+
+		for !w.until.After(c.now) {
+			w.until = w.until.Add(w.period)
+		}
+
+		return false
+	})
+}
+
+// After returns a channel receiving the time when the clock is advanced by d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// BlockUntil blocks until at least n timers, tickers and sleeps are waiting for the clock to be advanced, so a test
+// can advance it once the goroutines under test are waiting.
+func (c *FakeClock) BlockUntil(n int) {
+	for {
+		c.mu.Lock()
+
+		if len(c.waiters) >= n {
+			c.mu.Unlock()
+
+			return
+		}
+
+		if c.added == nil {
+			c.added = make(chan struct{})
+		}
+
+		added := c.added
+
+		c.mu.Unlock()
+
+		<-added
+	}
+}
+
+// NewTicker returns a Ticker that ticks each time the clock is advanced by d.
+// Panics if d is not positive.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &waiter{until: c.now.Add(d), period: d, c: make(chan time.Time, 1)}
+	c.add(w)
+
+	return fakeTicker{clock: c, waiter: w}
+}
+
+// NewTimer returns a Timer that fires when the clock is advanced by d, or immediately if d is not positive.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &waiter{until: c.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.fire()
+	} else {
+		c.add(w)
+	}
+
+	return fakeTimer{clock: c, waiter: w}
+}
+
+// Now returns the time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Sleep blocks until the clock is advanced by d, or returns immediately if d is not positive.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// add adds a waiter, waking up BlockUntil.
+func (c *FakeClock) add(w *waiter) {
+	c.waiters = append(c.waiters, w)
+
+	if c.added != nil {
+		close(c.added)
+		c.added = nil
+	}
+}
+
+// remove removes a waiter, returning whether it was waiting.
+func (c *FakeClock) remove(w *waiter) bool {
+	i := slices.Index(c.waiters, w)
+	if i < 0 {
+		return false
+	}
+
+	c.waiters = slices.Delete(c.waiters, i, i+1)
+
+	return true
+}
+
+// fire sends the expiration time, dropping it if the previous one was not received.
+func (w *waiter) fire() {
+	select {
+	case w.c <- w.until:
+	default:
+	}
+}
+
+// drain discards the time not received, as the Stop and Reset methods of the time package do since Go 1.23.
+func (w *waiter) drain() {
+	select {
+	case <-w.c:
+	default:
+	}
+}
+
+func (t fakeTimer) C() <-chan time.Time {
+	return t.waiter.c
+}
+
+func (t fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.clock.remove(t.waiter)
+	t.waiter.drain()
+
+	t.waiter.until = t.clock.now.Add(d)
+	if d <= 0 {
+		t.waiter.fire()
+	} else {
+		t.clock.add(t.waiter)
+	}
+
+	return active
+}
+
+func (t fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	active := t.clock.remove(t.waiter)
+	t.waiter.drain()
+
+	return active
+}
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.waiter.c
+}
+
+// Reset panics if d is not positive, as time.Ticker does.
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("clock: non-positive interval for Ticker.Reset")
+	}
+
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.remove(t.waiter)
+	t.waiter.drain()
+
+	t.waiter.until, t.waiter.period = t.clock.now.Add(d), d
+	t.clock.add(t.waiter)
+}
+
+func (t fakeTicker) Stop() {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	t.clock.remove(t.waiter)
+	t.waiter.drain()
+}
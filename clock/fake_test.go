@@ -0,0 +1,190 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:gochecknoglobals // start time shared by the tests.
+var start = time.Date(2024, time.March, 5, 18, 45, 0, 0, time.UTC)
+
+func TestFakeClockAdvance(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	c.Advance(90 * time.Minute)
+
+	if got, want := c.Now(), start.Add(90*time.Minute); !got.Equal(want) {
+		t.Errorf("Now = %v, want %v", got, want)
+	}
+
+	var zero FakeClock
+	zero.Advance(time.Second)
+
+	if got, want := zero.Now(), (time.Time{}).Add(time.Second); !got.Equal(want) {
+		t.Errorf("zero value Now = %v, want %v", got, want)
+	}
+}
+
+func TestFakeClockAfter(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	ch := c.After(time.Minute)
+
+	c.Advance(59 * time.Second)
+	assertNotReceived(t, ch)
+
+	c.Advance(2 * time.Second)
+	assertReceived(t, ch, start.Add(time.Minute))
+}
+
+func TestFakeClockAfterNotPositive(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	assertReceived(t, c.After(0), start)
+}
+
+func TestFakeClockOrder(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	late, early := c.NewTimer(2*time.Hour), c.NewTimer(time.Hour)
+
+	c.Advance(3 * time.Hour)
+
+	assertReceived(t, early.C(), start.Add(time.Hour))
+	assertReceived(t, late.C(), start.Add(2*time.Hour))
+}
+
+func TestFakeTimerStop(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	timer := c.NewTimer(time.Minute)
+
+	if !timer.Stop() {
+		t.Error("Stop of an active timer = false, want true")
+	}
+
+	c.Advance(time.Hour)
+	assertNotReceived(t, timer.C())
+
+	if timer.Stop() {
+		t.Error("Stop of a stopped timer = true, want false")
+	}
+}
+
+func TestFakeTimerReset(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	timer := c.NewTimer(time.Minute)
+
+	c.Advance(time.Minute)
+
+	if timer.Reset(time.Hour) {
+		t.Error("Reset of a fired timer = true, want false")
+	}
+
+	assertNotReceived(t, timer.C())
+
+	c.Advance(30 * time.Minute)
+
+	if !timer.Reset(time.Hour) {
+		t.Error("Reset of an active timer = false, want true")
+	}
+
+	c.Advance(59 * time.Minute)
+	assertNotReceived(t, timer.C())
+
+	c.Advance(time.Minute)
+	assertReceived(t, timer.C(), start.Add(91*time.Minute))
+}
+
+func TestFakeTicker(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	ticker := c.NewTicker(time.Minute)
+
+	c.Advance(time.Minute)
+	assertReceived(t, ticker.C(), start.Add(time.Minute))
+
+	c.Advance(3 * time.Minute)
+	assertReceived(t, ticker.C(), start.Add(2*time.Minute))
+	assertNotReceived(t, ticker.C())
+
+	c.Advance(time.Minute)
+	assertReceived(t, ticker.C(), start.Add(5*time.Minute))
+
+	ticker.Reset(time.Hour)
+	c.Advance(time.Minute)
+	assertNotReceived(t, ticker.C())
+
+	c.Advance(59 * time.Minute)
+	assertReceived(t, ticker.C(), start.Add(65*time.Minute))
+
+	ticker.Stop()
+	c.Advance(time.Hour)
+	assertNotReceived(t, ticker.C())
+}
+
+func TestFakeTickerNotPositive(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewTicker did not panic")
+		}
+	}()
+
+	NewFakeClock(start).NewTicker(0)
+}
+
+func TestFakeClockSleepBlockUntil(t *testing.T) {
+	t.Parallel()
+
+	c := NewFakeClock(start)
+	woken := make(chan time.Time)
+
+	for range 2 {
+		go func() {
+			c.Sleep(time.Second)
+			woken <- c.Now()
+		}()
+	}
+
+	c.BlockUntil(2)
+	c.Advance(time.Second)
+
+	for range 2 {
+		if got := <-woken; !got.Equal(start.Add(time.Second)) {
+			t.Errorf("woken at %v, want %v", got, start.Add(time.Second))
+		}
+	}
+}
+
+func assertReceived(t *testing.T, ch <-chan time.Time, want time.Time) {
+	t.Helper()
+
+	select {
+	case got := <-ch:
+		if !got.Equal(want) {
+			t.Errorf("received %v, want %v", got, want)
+		}
+	default:
+		t.Errorf("nothing received, want %v", want)
+	}
+}
+
+func assertNotReceived(t *testing.T, ch <-chan time.Time) {
+	t.Helper()
+
+	select {
+	case got := <-ch:
+		t.Errorf("received %v, want nothing", got)
+	default:
+	}
+}
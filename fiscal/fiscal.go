@@ -0,0 +1,82 @@
+// Package fiscal provides fiscal calendars, mapping dates to fiscal years, quarters, periods and weeks, either based
+// on months or on weeks, like the 4-4-5 retail calendars.
+package fiscal
+
+import (
+	"fmt"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+const (
+	// NamedByEndYear names a fiscal year after the year in which it ends, e.g. the United States federal fiscal year
+	// 2025 goes from October 2024 to September 2025.
+	NamedByEndYear YearNaming = iota
+	// NamedByStartYear names a fiscal year after the year in which it starts, e.g. the Japanese fiscal year 2024 goes
+	// from April 2024 to March 2025.
+	NamedByStartYear
+)
+
+type (
+	// Calendar maps dates to fiscal dates, and fiscal years, quarters, periods and weeks to their first and last days.
+	// Every fiscal year has 4 quarters of 3 periods each.
+	Calendar interface {
+		// Date returns the fiscal year, quarter, period and week of the date.
+		Date(ld localdate.LocalDate) Date
+		// Period returns the first and last days of the period, from 1 to 12, of the fiscal year.
+		// Returns a *RangeError if the period is out of range.
+		Period(year, period int) (localdate.LocalDate, localdate.LocalDate, error)
+		// Quarter returns the first and last days of the quarter, from 1 to 4, of the fiscal year.
+		// Returns a *RangeError if the quarter is out of range.
+		Quarter(year, quarter int) (localdate.LocalDate, localdate.LocalDate, error)
+		// Week returns the first and last days of the week of the fiscal year, the first week starting on the first
+		// day of the year.
+		// Returns a *RangeError if the week is out of range.
+		Week(year, week int) (localdate.LocalDate, localdate.LocalDate, error)
+		// Year returns the first and last days of the fiscal year.
+		Year(year int) (localdate.LocalDate, localdate.LocalDate)
+	}
+
+	// Date is the position of a date in a fiscal Calendar.
+	Date struct {
+		// Year is the fiscal year.
+		Year int
+		// Quarter of the fiscal year, from 1 to 4.
+		Quarter int
+		// Period of the fiscal year, from 1 to 12.
+		Period int
+		// Week of the fiscal year, from 1 to 53.
+		Week int
+	}
+
+	// YearNaming decides which calendar year gives its name to a fiscal year.
+	YearNaming int
+
+	// RangeError is returned when a fiscal field is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, e.g. "quarter" or "week".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
+)
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("fiscal: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
+
+// checkRange returns a *RangeError if value is not in [low, high].
+func checkRange(field string, value, low, high int) error {
+	if value < low || value > high {
+		return &RangeError{Field: field, Value: value, Min: low, Max: high}
+	}
+
+	return nil
+}
+
+// checkNaming returns a *RangeError if the naming is not one of the YearNaming constants.
+func checkNaming(naming YearNaming) error {
+	return checkRange("naming", int(naming), int(NamedByEndYear), int(NamedByStartYear))
+}
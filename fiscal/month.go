@@ -0,0 +1,122 @@
+package fiscal
+
+import (
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Calendar = MonthCalendar{}
+
+// MonthCalendar is a fiscal Calendar whose years start on the first day of a month, and whose periods are the
+// calendar months.
+// Weeks start on the first day of the fiscal year, so the 53rd week has only one or two days.
+// The zero value is a calendar starting in January, i.e. the fiscal year is the calendar year.
+type MonthCalendar struct {
+	// startMonth is stored as an offset from January, so the zero value is a valid calendar.
+	startMonth time.Month
+	naming     YearNaming
+}
+
+// NewMonthCalendar returns a MonthCalendar whose years start on the first day of startMonth, named according to
+// naming.
+// Returns a *RangeError if the month is not in [1, 12] or the naming is unknown.
+func NewMonthCalendar(startMonth time.Month, naming YearNaming) (MonthCalendar, error) {
+	if err := checkRange("month", int(startMonth), int(time.January), int(time.December)); err != nil {
+		return MonthCalendar{}, err
+	}
+
+	if err := checkNaming(naming); err != nil {
+		return MonthCalendar{}, err
+	}
+
+	return MonthCalendar{startMonth: startMonth - time.January, naming: naming}, nil
+}
+
+// Date returns the fiscal year, quarter, period and week of the date.
+func (c MonthCalendar) Date(ld localdate.LocalDate) Date {
+	year := ld.Year()
+	if ld.Month() < c.StartMonth() {
+		year--
+	}
+
+	first := localdate.New(year, c.StartMonth(), 1)
+	period := (ld.Year()-year)*12 + int(ld.Month()-c.StartMonth()) + 1
+
+	return Date{
+		Year:    c.fiscalYear(year),
+		Quarter: (period-1)/3 + 1,
+		Period:  period,
+		Week:    int(localdate.DaysBetween(first, ld))/7 + 1,
+	}
+}
+
+// Period returns the first and last days of the period, from 1 to 12, of the fiscal year.
+// Returns a *RangeError if the period is out of range.
+func (c MonthCalendar) Period(year, period int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("period", period, 1, 12); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	first, _ := c.Year(year)
+	first = first.PlusMonths(period - 1)
+
+	return first, first.PlusMonths(1).MinusDays(1), nil
+}
+
+// Quarter returns the first and last days of the quarter, from 1 to 4, of the fiscal year.
+// Returns a *RangeError if the quarter is out of range.
+func (c MonthCalendar) Quarter(year, quarter int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("quarter", quarter, 1, 4); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	first, _ := c.Year(year)
+	first = first.PlusMonths((quarter - 1) * 3)
+
+	return first, first.PlusMonths(3).MinusDays(1), nil
+}
+
+// StartMonth returns the month in which the fiscal years start.
+func (c MonthCalendar) StartMonth() time.Month {
+	return c.startMonth + time.January
+}
+
+// Week returns the first and last days of the week, from 1 to 53, of the fiscal year.
+// The 53rd week is cut at the end of the fiscal year.
+// Returns a *RangeError if the week is out of range.
+func (c MonthCalendar) Week(year, week int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("week", week, 1, 53); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	yearFirst, yearLast := c.Year(year)
+	first := yearFirst.PlusWeeks(week - 1)
+	last := first.PlusDays(6)
+
+	if last.After(yearLast) {
+		last = yearLast
+	}
+
+	return first, last, nil
+}
+
+// Year returns the first and last days of the fiscal year.
+func (c MonthCalendar) Year(year int) (localdate.LocalDate, localdate.LocalDate) {
+	if c.fiscalYear(year) != year {
+		year--
+	}
+
+	first := localdate.New(year, c.StartMonth(), 1)
+
+	return first, first.PlusYears(1).MinusDays(1)
+}
+
+// fiscalYear returns the fiscal year starting in the given calendar year.
+func (c MonthCalendar) fiscalYear(startYear int) int {
+	if c.naming == NamedByEndYear && c.StartMonth() != time.January {
+		return startYear + 1
+	}
+
+	return startYear
+}
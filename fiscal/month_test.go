@@ -0,0 +1,138 @@
+package fiscal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestNewMonthCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		month  time.Month
+		naming YearNaming
+	}{
+		"Month 0":        {month: 0},
+		"Month 13":       {month: 13},
+		"Unknown naming": {month: time.April, naming: 2},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var rangeErr *RangeError
+			if _, err := NewMonthCalendar(test.month, test.naming); !errors.As(err, &rangeErr) {
+				t.Errorf("NewMonthCalendar error = %v, want *RangeError", err)
+			}
+		})
+	}
+}
+
+func TestMonthCalendarDate(t *testing.T) {
+	t.Parallel()
+
+	usFederal, _ := NewMonthCalendar(time.October, NamedByEndYear)
+	japan, _ := NewMonthCalendar(time.April, NamedByStartYear)
+
+	tests := map[string]struct {
+		calendar MonthCalendar
+		ld       localdate.LocalDate
+		want     Date
+	}{
+		"Zero value is the calendar year": {
+			ld:   localdate.New(2024, time.August, 15),
+			want: Date{Year: 2024, Quarter: 3, Period: 8, Week: 33},
+		},
+		"Named by end year, first day": {
+			calendar: usFederal,
+			ld:       localdate.New(2024, time.October, 1),
+			want:     Date{Year: 2025, Quarter: 1, Period: 1, Week: 1},
+		},
+		"Named by end year": {
+			calendar: usFederal,
+			ld:       localdate.New(2024, time.November, 15),
+			want:     Date{Year: 2025, Quarter: 1, Period: 2, Week: 7},
+		},
+		"Named by end year, last day": {
+			calendar: usFederal,
+			ld:       localdate.New(2025, time.September, 30),
+			want:     Date{Year: 2025, Quarter: 4, Period: 12, Week: 53},
+		},
+		"Named by start year, last day": {
+			calendar: japan,
+			ld:       localdate.New(2025, time.March, 31),
+			want:     Date{Year: 2024, Quarter: 4, Period: 12, Week: 53},
+		},
+		"Named by start year": {
+			calendar: japan,
+			ld:       localdate.New(2024, time.July, 1),
+			want:     Date{Year: 2024, Quarter: 2, Period: 4, Week: 14},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.calendar.Date(test.ld); got != test.want {
+				t.Errorf("Date(%v) = %+v, want %+v", test.ld, got, test.want)
+			}
+		})
+	}
+}
+
+func TestMonthCalendarRanges(t *testing.T) {
+	t.Parallel()
+
+	usFederal, _ := NewMonthCalendar(time.October, NamedByEndYear)
+
+	first, last := usFederal.Year(2025)
+	assertRange(t, "Year", first, last, nil, localdate.New(2024, time.October, 1), localdate.New(2025, time.September, 30))
+
+	first, last, err := usFederal.Quarter(2025, 2)
+	assertRange(t, "Quarter", first, last, err, localdate.New(2025, time.January, 1), localdate.New(2025, time.March, 31))
+
+	first, last, err = usFederal.Period(2025, 5)
+	assertRange(t, "Period", first, last, err,
+		localdate.New(2025, time.February, 1), localdate.New(2025, time.February, 28))
+
+	first, last, err = usFederal.Week(2025, 2)
+	assertRange(t, "Week", first, last, err, localdate.New(2024, time.October, 8), localdate.New(2024, time.October, 14))
+
+	first, last, err = usFederal.Week(2025, 53)
+	assertRange(t, "Week 53", first, last, err,
+		localdate.New(2025, time.September, 30), localdate.New(2025, time.September, 30))
+
+	if got := usFederal.StartMonth(); got != time.October {
+		t.Errorf("StartMonth = %v, want October", got)
+	}
+
+	for name, err := range map[string]error{
+		"Quarter 5": second(usFederal.Quarter(2025, 5)),
+		"Period 0":  second(usFederal.Period(2025, 0)),
+		"Week 54":   second(usFederal.Week(2025, 54)),
+	} {
+		var rangeErr *RangeError
+		if !errors.As(err, &rangeErr) {
+			t.Errorf("%s error = %v, want *RangeError", name, err)
+		}
+	}
+}
+
+func assertRange(
+	t *testing.T, name string, first, last localdate.LocalDate, err error, wantFirst, wantLast localdate.LocalDate,
+) {
+	t.Helper()
+
+	if err != nil || first != wantFirst || last != wantLast {
+		t.Errorf("%s = %v, %v, %v, want %v, %v", name, first, last, err, wantFirst, wantLast)
+	}
+}
+
+func second(_, _ localdate.LocalDate, err error) error {
+	return err
+}
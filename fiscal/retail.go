@@ -0,0 +1,246 @@
+package fiscal
+
+import (
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ Calendar = RetailCalendar{}
+
+const (
+	// Pattern445 has 4 weeks in the first and second period of each quarter, and 5 in the third one.
+	Pattern445 Pattern = iota
+	// Pattern454 has 4 weeks in the first and third period of each quarter, and 5 in the second one.
+	Pattern454
+	// Pattern544 has 5 weeks in the first period of each quarter, and 4 in the second and third ones.
+	Pattern544
+)
+
+type (
+	// Pattern is the number of weeks in each of the three periods of a quarter of a RetailCalendar.
+	Pattern int
+
+	// Anchor decides on which day a year of a RetailCalendar starts or ends.
+	Anchor struct {
+		weekday time.Weekday
+		month   time.Month
+		nearest bool
+		ends    bool
+	}
+
+	// RetailCalendar is a fiscal Calendar made of whole weeks, as used in retail, so the same period of two years can be
+	// compared.
+	// Years have 52 weeks, or 53 to catch up with the calendar year, and start or end on the same day of the week
+	// according to an Anchor.
+	// Each quarter has 13 weeks split in periods following a Pattern, and the 53rd week is added to the 12th period.
+	RetailCalendar struct {
+		pattern Pattern
+		anchor  Anchor
+		naming  YearNaming
+	}
+)
+
+// EndsOnLast returns an Anchor to end the years on the last weekday of the month, e.g. the last Saturday of
+// September.
+func EndsOnLast(weekday time.Weekday, month time.Month) Anchor {
+	return Anchor{weekday: weekday, month: month, ends: true}
+}
+
+// EndsOnNearest returns an Anchor to end the years on the weekday nearest to the last day of the month, e.g. the
+// Saturday nearest to January 31st, as in the calendar of the National Retail Federation.
+func EndsOnNearest(weekday time.Weekday, month time.Month) Anchor {
+	return Anchor{weekday: weekday, month: month, nearest: true, ends: true}
+}
+
+// StartsOnLast returns an Anchor to start the years on the last weekday of the month, e.g. the last Saturday of
+// August.
+func StartsOnLast(weekday time.Weekday, month time.Month) Anchor {
+	return Anchor{weekday: weekday, month: month}
+}
+
+// StartsOnNearest returns an Anchor to start the years on the weekday nearest to the first day of the month, e.g. the
+// Sunday nearest to February 1st.
+func StartsOnNearest(weekday time.Weekday, month time.Month) Anchor {
+	return Anchor{weekday: weekday, month: month, nearest: true}
+}
+
+// NewRetailCalendar returns a RetailCalendar following the pattern, whose years start or end according to the anchor.
+// The fiscal years are named after the year of the anchor that starts them, or that ends them, according to naming,
+// e.g. a year ending on the Saturday nearest to January 31st 2025 is named 2024 with NamedByStartYear.
+// Returns a *RangeError if the pattern, the naming or the weekday and month of the anchor are unknown.
+func NewRetailCalendar(pattern Pattern, anchor Anchor, naming YearNaming) (RetailCalendar, error) {
+	if err := checkRange("pattern", int(pattern), int(Pattern445), int(Pattern544)); err != nil {
+		return RetailCalendar{}, err
+	}
+
+	if err := checkRange("weekday", int(anchor.weekday), int(time.Sunday), int(time.Saturday)); err != nil {
+		return RetailCalendar{}, err
+	}
+
+	if err := checkRange("month", int(anchor.month), int(time.January), int(time.December)); err != nil {
+		return RetailCalendar{}, err
+	}
+
+	if err := checkNaming(naming); err != nil {
+		return RetailCalendar{}, err
+	}
+
+	return RetailCalendar{pattern: pattern, anchor: anchor, naming: naming}, nil
+}
+
+// Date returns the fiscal year, quarter, period and week of the date.
+func (c RetailCalendar) Date(ld localdate.LocalDate) Date {
+	year := ld.Year() - 1
+
+	first, last := c.span(year)
+	for last.Before(ld) {
+		year++
+		first, last = c.span(year)
+	}
+
+	weekIndex := int(localdate.DaysBetween(first, ld)) / 7
+	weeks := weeksIn(first, last)
+
+	period := 12
+	for p := 1; p < 12; p++ {
+		start, n := c.periodWeeks(p, weeks)
+		if weekIndex < start+n {
+			period = p
+
+			break
+		}
+	}
+
+	return Date{
+		Year:    c.fiscalYear(year),
+		Quarter: (period-1)/3 + 1,
+		Period:  period,
+		Week:    weekIndex + 1,
+	}
+}
+
+// Period returns the first and last days of the period, from 1 to 12, of the fiscal year.
+// Returns a *RangeError if the period is out of range.
+func (c RetailCalendar) Period(year, period int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("period", period, 1, 12); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	yearFirst, yearLast := c.Year(year)
+	start, n := c.periodWeeks(period, weeksIn(yearFirst, yearLast))
+	first := yearFirst.PlusWeeks(start)
+
+	return first, first.PlusWeeks(n).MinusDays(1), nil
+}
+
+// Quarter returns the first and last days of the quarter, from 1 to 4, of the fiscal year.
+// The 4th quarter has 14 weeks in years of 53 weeks.
+// Returns a *RangeError if the quarter is out of range.
+func (c RetailCalendar) Quarter(year, quarter int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("quarter", quarter, 1, 4); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	yearFirst, yearLast := c.Year(year)
+	first := yearFirst.PlusWeeks((quarter - 1) * 13)
+
+	if quarter == 4 {
+		return first, yearLast, nil
+	}
+
+	return first, first.PlusWeeks(13).MinusDays(1), nil
+}
+
+// Week returns the first and last days of the week of the fiscal year, from 1 to 52, or 53 in long years.
+// Returns a *RangeError if the week is out of range.
+func (c RetailCalendar) Week(year, week int) (localdate.LocalDate, localdate.LocalDate, error) {
+	if err := checkRange("week", week, 1, c.WeeksInYear(year)); err != nil {
+		return localdate.LocalDate{}, localdate.LocalDate{}, err
+	}
+
+	yearFirst, _ := c.Year(year)
+	first := yearFirst.PlusWeeks(week - 1)
+
+	return first, first.PlusDays(6), nil
+}
+
+// WeeksInYear returns the number of weeks of the fiscal year, 52 or 53.
+func (c RetailCalendar) WeeksInYear(year int) int {
+	return weeksIn(c.Year(year))
+}
+
+// Year returns the first and last days of the fiscal year.
+func (c RetailCalendar) Year(year int) (localdate.LocalDate, localdate.LocalDate) {
+	return c.span(year - c.fiscalYear(0))
+}
+
+// fiscalYear returns the fiscal year of the span of the given anchor year.
+func (c RetailCalendar) fiscalYear(anchorYear int) int {
+	switch {
+	case c.anchor.ends && c.naming == NamedByStartYear:
+		return anchorYear - 1
+	case !c.anchor.ends && c.naming == NamedByEndYear:
+		return anchorYear + 1
+	default:
+		return anchorYear
+	}
+}
+
+// periodWeeks returns the index of the first week of the period, and its number of weeks.
+func (c RetailCalendar) periodWeeks(period, weeksInYear int) (int, int) {
+	weeks := [...][3]int{
+		Pattern445: {4, 4, 5},
+		Pattern454: {4, 5, 4},
+		Pattern544: {5, 4, 4},
+	}[c.pattern]
+
+	quarter, i := (period-1)/3, (period-1)%3
+
+	start := quarter * 13
+	for _, n := range weeks[:i] {
+		start += n
+	}
+
+	n := weeks[i]
+	if period == 12 {
+		n += weeksInYear - 52
+	}
+
+	return start, n
+}
+
+// span returns the first and last days of the fiscal year anchored in the given calendar year.
+// Years starting on the anchor end the day before the anchor of the next year, and years ending on the anchor start
+// the day after the anchor of the previous year.
+func (c RetailCalendar) span(anchorYear int) (localdate.LocalDate, localdate.LocalDate) {
+	if c.anchor.ends {
+		return c.anchor.date(anchorYear - 1).PlusDays(1), c.anchor.date(anchorYear)
+	}
+
+	return c.anchor.date(anchorYear), c.anchor.date(anchorYear + 1).MinusDays(1)
+}
+
+// date returns the day of the anchor in the given calendar year.
+func (a Anchor) date(year int) localdate.LocalDate {
+	if !a.nearest {
+		return localdate.New(year, a.month, 1).With(localdate.LastInMonth(a.weekday))
+	}
+
+	target := localdate.New(year, a.month, 1)
+	if a.ends {
+		target = target.With(localdate.LastDayOfMonth())
+	}
+
+	diff := (int(a.weekday) - int(target.Weekday()) + 7) % 7
+	if diff > 3 {
+		diff -= 7
+	}
+
+	return target.PlusDays(diff)
+}
+
+// weeksIn returns the number of weeks from first to last, both inclusive.
+func weeksIn(first, last localdate.LocalDate) int {
+	return int(localdate.DaysBetween(first, last)+1) / 7
+}
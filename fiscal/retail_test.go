@@ -0,0 +1,202 @@
+package fiscal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestNewRetailCalendar(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern Pattern
+		anchor  Anchor
+		naming  YearNaming
+	}{
+		"Unknown pattern": {pattern: 3, anchor: EndsOnLast(time.Saturday, time.September)},
+		"Unknown weekday": {anchor: EndsOnLast(7, time.September)},
+		"Unknown month":   {anchor: EndsOnLast(time.Saturday, 13)},
+		"Zero anchor":     {},
+		"Unknown naming":  {anchor: EndsOnLast(time.Saturday, time.September), naming: -1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var rangeErr *RangeError
+			if _, err := NewRetailCalendar(test.pattern, test.anchor, test.naming); !errors.As(err, &rangeErr) {
+				t.Errorf("NewRetailCalendar error = %v, want *RangeError", err)
+			}
+		})
+	}
+}
+
+func TestRetailCalendarYear(t *testing.T) {
+	t.Parallel()
+
+	nrf, _ := NewRetailCalendar(Pattern454, EndsOnNearest(time.Saturday, time.January), NamedByStartYear)
+	apple, _ := NewRetailCalendar(Pattern445, EndsOnLast(time.Saturday, time.September), NamedByEndYear)
+	startsOnLast, _ := NewRetailCalendar(Pattern544, StartsOnLast(time.Saturday, time.August), NamedByEndYear)
+	startsOnNearest, _ := NewRetailCalendar(Pattern454, StartsOnNearest(time.Sunday, time.February), NamedByStartYear)
+
+	tests := map[string]struct {
+		calendar  RetailCalendar
+		year      int
+		wantFirst localdate.LocalDate
+		wantLast  localdate.LocalDate
+		wantWeeks int
+	}{
+		"Ends on nearest, 52 weeks": {
+			calendar:  nrf,
+			year:      2024,
+			wantFirst: localdate.New(2024, time.February, 4),
+			wantLast:  localdate.New(2025, time.February, 1),
+			wantWeeks: 52,
+		},
+		"Ends on nearest, 53 weeks": {
+			calendar:  nrf,
+			year:      2023,
+			wantFirst: localdate.New(2023, time.January, 29),
+			wantLast:  localdate.New(2024, time.February, 3),
+			wantWeeks: 53,
+		},
+		"Ends on last, 52 weeks": {
+			calendar:  apple,
+			year:      2024,
+			wantFirst: localdate.New(2023, time.October, 1),
+			wantLast:  localdate.New(2024, time.September, 28),
+			wantWeeks: 52,
+		},
+		"Ends on last, 53 weeks": {
+			calendar:  apple,
+			year:      2023,
+			wantFirst: localdate.New(2022, time.September, 25),
+			wantLast:  localdate.New(2023, time.September, 30),
+			wantWeeks: 53,
+		},
+		"Starts on last": {
+			calendar:  startsOnLast,
+			year:      2025,
+			wantFirst: localdate.New(2024, time.August, 31),
+			wantLast:  localdate.New(2025, time.August, 29),
+			wantWeeks: 52,
+		},
+		"Starts on nearest": {
+			calendar:  startsOnNearest,
+			year:      2024,
+			wantFirst: localdate.New(2024, time.February, 4),
+			wantLast:  localdate.New(2025, time.February, 1),
+			wantWeeks: 52,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first, last := test.calendar.Year(test.year)
+			assertRange(t, "Year", first, last, nil, test.wantFirst, test.wantLast)
+
+			if got := test.calendar.WeeksInYear(test.year); got != test.wantWeeks {
+				t.Errorf("WeeksInYear = %d, want %d", got, test.wantWeeks)
+			}
+
+			if got := test.calendar.Date(first); got != (Date{Year: test.year, Quarter: 1, Period: 1, Week: 1}) {
+				t.Errorf("Date(%v) = %+v, want first day of %d", first, got, test.year)
+			}
+
+			want := Date{Year: test.year, Quarter: 4, Period: 12, Week: test.wantWeeks}
+			if got := test.calendar.Date(last); got != want {
+				t.Errorf("Date(%v) = %+v, want %+v", last, got, want)
+			}
+		})
+	}
+}
+
+func TestRetailCalendarPeriods(t *testing.T) {
+	t.Parallel()
+
+	nrf, _ := NewRetailCalendar(Pattern454, EndsOnNearest(time.Saturday, time.January), NamedByStartYear)
+
+	tests := map[string]struct {
+		year, period        int
+		wantFirst, wantLast localdate.LocalDate
+	}{
+		"First period": {
+			year:      2024,
+			period:    1,
+			wantFirst: localdate.New(2024, time.February, 4),
+			wantLast:  localdate.New(2024, time.March, 2),
+		},
+		"Second period, 5 weeks": {
+			year:      2024,
+			period:    2,
+			wantFirst: localdate.New(2024, time.March, 3),
+			wantLast:  localdate.New(2024, time.April, 6),
+		},
+		"Third period": {
+			year:      2024,
+			period:    3,
+			wantFirst: localdate.New(2024, time.April, 7),
+			wantLast:  localdate.New(2024, time.May, 4),
+		},
+		"Last period of a 53 week year": {
+			year:      2023,
+			period:    12,
+			wantFirst: localdate.New(2023, time.December, 31),
+			wantLast:  localdate.New(2024, time.February, 3),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			first, last, err := nrf.Period(test.year, test.period)
+			assertRange(t, "Period", first, last, err, test.wantFirst, test.wantLast)
+
+			for _, ld := range []localdate.LocalDate{first, last} {
+				if got := nrf.Date(ld); got.Year != test.year || got.Period != test.period {
+					t.Errorf("Date(%v) = %+v, want year %d and period %d", ld, got, test.year, test.period)
+				}
+			}
+		})
+	}
+}
+
+func TestRetailCalendarQuartersAndWeeks(t *testing.T) {
+	t.Parallel()
+
+	nrf, _ := NewRetailCalendar(Pattern454, EndsOnNearest(time.Saturday, time.January), NamedByStartYear)
+
+	first, last, err := nrf.Quarter(2023, 2)
+	assertRange(t, "Quarter 2", first, last, err, localdate.New(2023, time.April, 30), localdate.New(2023, time.July, 29))
+
+	first, last, err = nrf.Quarter(2023, 4)
+	assertRange(t, "Quarter 4", first, last, err,
+		localdate.New(2023, time.October, 29), localdate.New(2024, time.February, 3))
+
+	first, last, err = nrf.Week(2023, 53)
+	assertRange(t, "Week 53", first, last, err,
+		localdate.New(2024, time.January, 28), localdate.New(2024, time.February, 3))
+
+	want := Date{Year: 2023, Quarter: 4, Period: 11, Week: 48}
+	if got := nrf.Date(localdate.New(2023, time.December, 25)); got != want {
+		t.Errorf("Date = %+v, want %+v", got, want)
+	}
+
+	for name, err := range map[string]error{
+		"Quarter 0": second(nrf.Quarter(2024, 0)),
+		"Period 13": second(nrf.Period(2024, 13)),
+		"Week 53":   second(nrf.Week(2024, 53)),
+	} {
+		var rangeErr *RangeError
+		if !errors.As(err, &rangeErr) {
+			t.Errorf("%s error = %v, want *RangeError", name, err)
+		}
+	}
+}
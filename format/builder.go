@@ -0,0 +1,298 @@
+package format
+
+import (
+	"fmt"
+)
+
+// Fields that can be formatted and parsed.
+const (
+	// Era is 0 before Christ, and 1 for the current era.
+	Era Field = iota
+	// Year is the proleptic year, where the year 0 is 1 BC.
+	Year
+	// YearOfEra is the year within the era, always positive.
+	YearOfEra
+	// QuarterOfYear is the quarter of the year, from 1 to 4.
+	QuarterOfYear
+	// MonthOfYear is the month, from 1 (January) to 12 (December).
+	MonthOfYear
+	// DayOfMonth is the day of the month, from 1 to 31.
+	DayOfMonth
+	// DayOfYear is the day of the year, from 1 to 366.
+	DayOfYear
+	// DayOfWeek is the ISO day of the week, from 1 (Monday) to 7 (Sunday).
+	DayOfWeek
+	// AmPmOfDay is 0 before noon, and 1 after.
+	AmPmOfDay
+	// HourOfDay is the hour of the day, from 0 to 23.
+	HourOfDay
+	// ClockHourOfDay is the hour of the day, from 1 to 24.
+	ClockHourOfDay
+	// HourOfAmPm is the hour within the morning or the afternoon, from 0 to 11.
+	HourOfAmPm
+	// ClockHourOfAmPm is the hour within the morning or the afternoon, from 1 to 12.
+	ClockHourOfAmPm
+	// MinuteOfHour is the minute of the hour, from 0 to 59.
+	MinuteOfHour
+	// SecondOfMinute is the second of the minute, from 0 to 59.
+	SecondOfMinute
+	// NanoOfSecond is the nanosecond of the second, from 0 to 999,999,999.
+	NanoOfSecond
+
+	fieldCount
+)
+
+// Text styles, the standalone ones being used for a field on its own, e.g. a month without a day, in the languages
+// where it makes a difference.
+const (
+	// TextFull is the full text, e.g. "January".
+	TextFull TextStyle = iota
+	// TextShort is the abbreviated text, e.g. "Jan".
+	TextShort
+	// TextNarrow is the shortest text, often a single letter, e.g. "J".
+	TextNarrow
+	// TextFullStandalone is the full text used on its own.
+	TextFullStandalone
+	// TextShortStandalone is the abbreviated text used on its own.
+	TextShortStandalone
+	// TextNarrowStandalone is the shortest text used on its own.
+	TextNarrowStandalone
+
+	textStyleCount
+)
+
+// Sign styles.
+const (
+	// SignNormal outputs the sign only for negative values, and only accepts a '-' when parsing.
+	SignNormal SignStyle = iota
+	// SignAlways always outputs the sign, and requires it when parsing.
+	SignAlways
+	// SignNever outputs the absolute value, and does not accept a sign when parsing.
+	SignNever
+	// SignNotNegative fails to format negative values, and does not accept a sign when parsing.
+	SignNotNegative
+	// SignExceedsPad outputs the sign for negative values, and a '+' for positive values wider than the minimum width.
+	SignExceedsPad
+)
+
+type (
+	// Field is a date or time field that can be formatted and parsed.
+	Field int
+
+	// TextStyle is the style of the text of a field, e.g. "January", "Jan" or "J".
+	TextStyle int
+
+	// SignStyle decides how the sign of a numeric field is formatted and parsed.
+	SignStyle int
+
+	// Builder builds a Formatter piece by piece, giving control over padding and optional sections.
+	// Its methods return the Builder, so they can be chained, and the first error is reported by Build.
+	// The zero value is an empty Builder ready to use.
+	Builder struct {
+		// stack contains the elements of the formatter, followed by the elements of each open optional section.
+		stack [][]element
+		pad   *pad
+		err   error
+	}
+)
+
+var fieldNames = [...]string{ //nolint:gochecknoglobals // table of names, like a constant.
+	Era:             "Era",
+	Year:            "Year",
+	YearOfEra:       "YearOfEra",
+	QuarterOfYear:   "QuarterOfYear",
+	MonthOfYear:     "MonthOfYear",
+	DayOfMonth:      "DayOfMonth",
+	DayOfYear:       "DayOfYear",
+	DayOfWeek:       "DayOfWeek",
+	AmPmOfDay:       "AmPmOfDay",
+	HourOfDay:       "HourOfDay",
+	ClockHourOfDay:  "ClockHourOfDay",
+	HourOfAmPm:      "HourOfAmPm",
+	ClockHourOfAmPm: "ClockHourOfAmPm",
+	MinuteOfHour:    "MinuteOfHour",
+	SecondOfMinute:  "SecondOfMinute",
+	NanoOfSecond:    "NanoOfSecond",
+}
+
+// NewBuilder returns an empty Builder.
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+// AppendFraction appends the NanoOfSecond field as a fraction of a second, with from minWidth to maxWidth digits,
+// at most 9, dropping the trailing zeros beyond minWidth.
+// If decimalPoint is true, a '.' is output before the digits, if there is any.
+func (b *Builder) AppendFraction(minWidth, maxWidth int, decimalPoint bool) *Builder {
+	if minWidth < 0 || maxWidth > 9 || maxWidth < 1 || minWidth > maxWidth {
+		return b.fail(fmt.Errorf("format: invalid fraction width [%d, %d]", minWidth, maxWidth))
+	}
+
+	return b.append(&fraction{minWidth: minWidth, maxWidth: maxWidth, decimalPoint: decimalPoint})
+}
+
+// AppendLiteral appends a text that is output as is, and that must be matched exactly when parsing.
+func (b *Builder) AppendLiteral(text string) *Builder {
+	if text == "" {
+		return b
+	}
+
+	return b.append(literal(text))
+}
+
+// AppendOffset appends the offset from UTC of a time.Time, following a java.time offset pattern: "+HH", "+HHmm",
+// "+HH:mm", "+HHMM", "+HH:MM", "+HHMMss", "+HH:MM:ss", "+HHMMSS" or "+HH:MM:SS", where the lowercase fields are only
+// output if they are not zero.
+// noOffsetText is output instead of a zero offset, e.g. "Z".
+func (b *Builder) AppendOffset(pattern, noOffsetText string) *Builder {
+	style, ok := offsetStyles[pattern]
+	if !ok {
+		return b.fail(fmt.Errorf("format: invalid offset pattern %q", pattern))
+	}
+
+	return b.append(&offset{style: style, noOffsetText: noOffsetText})
+}
+
+// AppendPattern appends the elements of a pattern, as described in New.
+func (b *Builder) AppendPattern(pattern string) *Builder {
+	if err := appendPattern(b, pattern); err != nil {
+		return b.fail(err)
+	}
+
+	return b
+}
+
+// AppendText appends the text of the field, which must be Era, QuarterOfYear, MonthOfYear, DayOfWeek or AmPmOfDay.
+func (b *Builder) AppendText(field Field, style TextStyle) *Builder {
+	if !hasText(field) {
+		return b.fail(fmt.Errorf("format: field %v has no text", field))
+	}
+
+	if style < 0 || style >= textStyleCount {
+		return b.fail(fmt.Errorf("format: unknown text style %d", style))
+	}
+
+	return b.append(&text{field: field, style: style})
+}
+
+// AppendValue appends the numeric value of the field, left padded with zeros to minWidth digits, and with at most
+// maxWidth digits.
+// When parsing, a field with a variable width followed by fixed width fields only consumes the digits not needed by
+// them, so patterns like yyyyMMdd can be parsed.
+func (b *Builder) AppendValue(field Field, minWidth, maxWidth int, sign SignStyle) *Builder {
+	if field < 0 || field >= fieldCount {
+		return b.fail(fmt.Errorf("format: unknown field %d", field))
+	}
+
+	if minWidth < 1 || maxWidth > 19 || minWidth > maxWidth {
+		return b.fail(fmt.Errorf("format: invalid width [%d, %d] for field %v", minWidth, maxWidth, field))
+	}
+
+	if sign < SignNormal || sign > SignExceedsPad {
+		return b.fail(fmt.Errorf("format: unknown sign style %d", sign))
+	}
+
+	return b.append(&value{field: field, minWidth: minWidth, maxWidth: maxWidth, sign: sign})
+}
+
+// AppendZoneID appends the name of the location of a time.Time, e.g. "Europe/Madrid".
+func (b *Builder) AppendZoneID() *Builder {
+	return b.append(zoneID{})
+}
+
+// Build returns the Formatter, or the first error of the Builder.
+func (b *Builder) Build() (Formatter, error) {
+	if b.err != nil {
+		return Formatter{}, b.err
+	}
+
+	if len(b.stack) > 1 {
+		return Formatter{}, fmt.Errorf("format: %d optional sections not ended", len(b.stack)-1)
+	}
+
+	if b.pad != nil {
+		return Formatter{}, fmt.Errorf("format: padding not followed by any element")
+	}
+
+	// The elements are copied, so reusing the Builder does not change the Formatters already built.
+	var elements []element
+	if len(b.stack) == 1 {
+		elements = cloneElements(b.stack[0])
+	}
+
+	reserveWidths(elements)
+
+	return Formatter{elements: elements, locale: defaultLocale, symbols: english()}, nil
+}
+
+// OptionalEnd ends the last started optional section.
+func (b *Builder) OptionalEnd() *Builder {
+	if len(b.stack) < 2 {
+		return b.fail(fmt.Errorf("format: optional section ended without being started"))
+	}
+
+	section := b.stack[len(b.stack)-1]
+	b.stack = b.stack[:len(b.stack)-1]
+
+	return b.append(optional(section))
+}
+
+// OptionalStart starts an optional section, that is skipped when formatting if any of its fields is not available,
+// e.g. the time of a LocalDate, and when parsing if it does not match the text.
+// Optional sections can be nested, and are ended by OptionalEnd.
+func (b *Builder) OptionalStart() *Builder {
+	if len(b.stack) == 0 {
+		b.stack = append(b.stack, nil)
+	}
+
+	b.stack = append(b.stack, nil)
+
+	return b
+}
+
+// PadNext pads the next appended element to width characters with padChar on its left.
+func (b *Builder) PadNext(width int, padChar rune) *Builder {
+	if width < 1 {
+		return b.fail(fmt.Errorf("format: invalid pad width %d", width))
+	}
+
+	b.pad = &pad{width: width, char: padChar}
+
+	return b
+}
+
+func (b *Builder) append(e element) *Builder {
+	if b.pad != nil {
+		b.pad.element = e
+		e, b.pad = b.pad, nil
+	}
+
+	if len(b.stack) == 0 {
+		b.stack = append(b.stack, nil)
+	}
+
+	b.stack[len(b.stack)-1] = append(b.stack[len(b.stack)-1], e)
+
+	return b
+}
+
+func (b *Builder) fail(err error) *Builder {
+	if b.err == nil {
+		b.err = err
+	}
+
+	return b
+}
+
+func (f Field) String() string {
+	if f < 0 || f >= fieldCount {
+		return fmt.Sprintf("Field(%d)", int(f))
+	}
+
+	return fieldNames[f]
+}
+
+// base returns the style without the standalone distinction.
+func (s TextStyle) base() TextStyle {
+	return s % TextFullStandalone
+}
@@ -0,0 +1,185 @@
+package format
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		builder *Builder
+		lt      localtime.LocalTime
+		want    string
+	}{
+		"Pad next": {
+			builder: NewBuilder().PadNext(3, '*').AppendValue(HourOfDay, 1, 2, SignNormal),
+			lt:      localtime.New(9, 0, 0, 0),
+			want:    "**9",
+		},
+		"Always sign": {
+			builder: NewBuilder().AppendValue(MinuteOfHour, 2, 2, SignAlways),
+			lt:      localtime.New(9, 5, 0, 0),
+			want:    "+05",
+		},
+		"Fraction with decimal point": {
+			builder: NewBuilder().AppendValue(SecondOfMinute, 2, 2, SignNotNegative).AppendFraction(0, 9, true),
+			lt:      localtime.New(9, 5, 7, 120_000_000),
+			want:    "07.12",
+		},
+		"Fraction without nanoseconds": {
+			builder: NewBuilder().AppendValue(SecondOfMinute, 2, 2, SignNotNegative).AppendFraction(0, 9, true),
+			lt:      localtime.New(9, 5, 7, 0),
+			want:    "07",
+		},
+		"Minimum fraction": {
+			builder: NewBuilder().AppendFraction(3, 6, false),
+			lt:      localtime.New(9, 5, 7, 0),
+			want:    "000",
+		},
+		"Text": {
+			builder: NewBuilder().AppendText(AmPmOfDay, TextNarrow),
+			lt:      localtime.New(19, 5, 7, 0),
+			want:    "p",
+		},
+		"Nested optional sections": {
+			builder: NewBuilder().
+				AppendPattern("HH").
+				OptionalStart().AppendLiteral(":").AppendPattern("mm").
+				OptionalStart().AppendLiteral(" ").AppendPattern("d").OptionalEnd().
+				OptionalEnd(),
+			lt:   localtime.New(19, 5, 7, 0),
+			want: "19:05",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := test.builder.Build()
+			if err != nil {
+				t.Fatalf("Build error = %v", err)
+			}
+
+			got, err := f.FormatLocalTime(test.lt)
+			if err != nil || got != test.want {
+				t.Errorf("FormatLocalTime = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestBuilderParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		builder *Builder
+		value   string
+		want    localdate.LocalDate
+	}{
+		"Padded values": {
+			builder: NewBuilder().
+				AppendPattern("uuuu-MM-").
+				PadNext(2, ' ').AppendValue(DayOfMonth, 1, 2, SignNotNegative),
+			value: "2024-03- 5",
+			want:  localdate.New(2024, time.March, 5),
+		},
+		"Padded text": {
+			builder: NewBuilder().
+				PadNext(5, '.').AppendText(MonthOfYear, TextShort).
+				AppendPattern(" d uuuu"),
+			value: "..Mar 5 2024",
+			want:  localdate.New(2024, time.March, 5),
+		},
+		"Optional time missing": {
+			builder: NewBuilder().
+				AppendPattern("uuuu-MM-dd").
+				OptionalStart().AppendLiteral("T").AppendPattern("HH:mm").OptionalEnd(),
+			value: "2024-03-05",
+			want:  localdate.New(2024, time.March, 5),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := test.builder.Build()
+			if err != nil {
+				t.Fatalf("Build error = %v", err)
+			}
+
+			got, err := f.ParseLocalDate(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("ParseLocalDate = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestBuilderReuse(t *testing.T) {
+	t.Parallel()
+
+	builder := NewBuilder().
+		AppendValue(Year, 1, 9, SignNormal).
+		AppendValue(DayOfYear, 3, 3, SignNotNegative)
+
+	first, err := builder.Build()
+	if err != nil {
+		t.Fatalf("Build error = %v", err)
+	}
+
+	// The hour makes the year of the second Formatter leave two more digits, but not the one of the first.
+	second, err := builder.AppendValue(HourOfDay, 2, 2, SignNotNegative).Build()
+	if err != nil {
+		t.Fatalf("Build error = %v", err)
+	}
+
+	want := localdate.New(2024, time.February, 29)
+	if got, err := first.ParseLocalDate("2024060"); err != nil || got != want {
+		t.Errorf("first ParseLocalDate = %v, %v, want %v", got, err, want)
+	}
+
+	if got, err := second.ParseLocalDate("202406010"); err != nil || got != want {
+		t.Errorf("second ParseLocalDate = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestBuilderError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		builder *Builder
+	}{
+		"Unknown field":           {builder: NewBuilder().AppendValue(fieldCount, 1, 2, SignNormal)},
+		"Invalid width":           {builder: NewBuilder().AppendValue(Year, 4, 2, SignNormal)},
+		"Unknown sign style":      {builder: NewBuilder().AppendValue(Year, 4, 4, SignExceedsPad+1)},
+		"Negative sign style":     {builder: NewBuilder().AppendValue(Year, 4, 4, -1)},
+		"Field without text":      {builder: NewBuilder().AppendText(HourOfDay, TextFull)},
+		"Unknown text style":      {builder: NewBuilder().AppendText(MonthOfYear, 42)},
+		"Negative text style":     {builder: NewBuilder().AppendText(MonthOfYear, -1)},
+		"Invalid fraction":        {builder: NewBuilder().AppendFraction(0, 10, true)},
+		"Invalid offset pattern":  {builder: NewBuilder().AppendOffset("+H", "Z")},
+		"Invalid pad":             {builder: NewBuilder().PadNext(0, ' ')},
+		"Pad without element":     {builder: NewBuilder().AppendPattern("HH").PadNext(2, ' ')},
+		"Optional not started":    {builder: NewBuilder().OptionalEnd()},
+		"Optional not ended":      {builder: NewBuilder().OptionalStart().AppendPattern("HH")},
+		"Invalid pattern":         {builder: NewBuilder().AppendPattern("HHH")},
+		"First error is reported": {builder: NewBuilder().PadNext(0, ' ').AppendPattern("HH")},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.builder.Build(); err == nil {
+				t.Error("Build error = nil, want error")
+			}
+		})
+	}
+}
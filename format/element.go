@@ -0,0 +1,637 @@
+package format
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// offsetStyles are the offset patterns supported by AppendOffset.
+var offsetStyles = map[string]offsetStyle{ //nolint:gochecknoglobals // table of patterns, like a constant.
+	"+HH":       {},
+	"+HHmm":     {minutes: optionalPart},
+	"+HH:mm":    {minutes: optionalPart, colon: true},
+	"+HHMM":     {minutes: requiredPart},
+	"+HH:MM":    {minutes: requiredPart, colon: true},
+	"+HHMMss":   {minutes: requiredPart, seconds: optionalPart},
+	"+HH:MM:ss": {minutes: requiredPart, seconds: optionalPart, colon: true},
+	"+HHMMSS":   {minutes: requiredPart, seconds: requiredPart},
+	"+HH:MM:SS": {minutes: requiredPart, seconds: requiredPart, colon: true},
+}
+
+// Whether a part of an offset is output.
+const (
+	noPart = iota
+	optionalPart
+	requiredPart
+)
+
+type (
+	// element is a piece of a Formatter.
+	element interface {
+		// format appends the element to b.
+		format(b []byte, v *values, s *symbols) ([]byte, error)
+		// parse parses the element from text at pos, storing the fields in v, and returns the position after it.
+		// The error is a *ParseError without its Text.
+		parse(text string, pos int, v *values, s *symbols) (int, error)
+	}
+
+	// literal is a text output as is.
+	literal string
+
+	// value is the numeric value of a field.
+	value struct {
+		field              Field
+		minWidth, maxWidth int
+		sign               SignStyle
+		// reserved is the number of digits needed by the fixed width values that follow.
+		reserved int
+	}
+
+	// reduced is a field output with its last two digits, and parsed into the century starting at base.
+	reduced struct {
+		field Field
+		base  int64
+	}
+
+	// text is the text of a field.
+	text struct {
+		field Field
+		style TextStyle
+	}
+
+	// fraction is the NanoOfSecond field as a fraction of a second.
+	fraction struct {
+		minWidth, maxWidth int
+		decimalPoint       bool
+	}
+
+	offsetStyle struct {
+		minutes, seconds int
+		colon            bool
+	}
+
+	// offset is the offset from UTC.
+	offset struct {
+		style        offsetStyle
+		noOffsetText string
+	}
+
+	// zoneID is the name of the location.
+	zoneID struct{}
+
+	// optional is a section that is skipped if any of its fields is missing when formatting, or if it does not match
+	// when parsing.
+	optional []element
+
+	// pad pads an element to a width.
+	pad struct {
+		element element
+		width   int
+		char    rune
+	}
+)
+
+func (l literal) format(b []byte, _ *values, _ *symbols) ([]byte, error) {
+	return append(b, l...), nil
+}
+
+func (l literal) parse(text string, pos int, _ *values, _ *symbols) (int, error) {
+	if !strings.HasPrefix(text[pos:], string(l)) {
+		return pos, failure(pos, "expected %q", string(l))
+	}
+
+	return pos + len(l), nil
+}
+
+func (e *value) format(b []byte, v *values, _ *symbols) ([]byte, error) {
+	n, ok := v.get(e.field)
+	if !ok {
+		return b, fmt.Errorf("%w: %v", ErrUnsupportedField, e.field)
+	}
+
+	abs := n
+	if n < 0 {
+		abs = -n
+	}
+
+	digits := strconv.FormatInt(abs, 10)
+	if len(digits) > e.maxWidth {
+		return b, fmt.Errorf("format: %v %d exceeds %d digits", e.field, n, e.maxWidth)
+	}
+
+	switch {
+	case n < 0 && e.sign == SignNotNegative:
+		return b, fmt.Errorf("format: %v %d is negative", e.field, n)
+	case n < 0 && e.sign != SignNever:
+		b = append(b, '-')
+	case n >= 0 && (e.sign == SignAlways || e.sign == SignExceedsPad && len(digits) > e.minWidth):
+		b = append(b, '+')
+	}
+
+	for range e.minWidth - len(digits) {
+		b = append(b, '0')
+	}
+
+	return append(b, digits...), nil
+}
+
+func (e *value) parse(text string, pos int, v *values, _ *symbols) (int, error) {
+	start := pos
+
+	var negative, positive bool
+
+	if pos < len(text) {
+		negative, positive = text[pos] == '-', text[pos] == '+'
+	}
+
+	switch {
+	case (negative || positive) && (e.sign == SignNever || e.sign == SignNotNegative):
+		return start, failure(pos, "unexpected sign for %v", e.field)
+	case positive && e.sign == SignNormal:
+		return start, failure(pos, "unexpected sign for %v", e.field)
+	case !negative && !positive && e.sign == SignAlways:
+		return start, failure(pos, "expected sign for %v", e.field)
+	case negative || positive:
+		pos++
+	}
+
+	available := digitsAt(text, pos)
+
+	width := min(e.maxWidth, available-e.reserved)
+	if width < e.minWidth {
+		return start, failure(pos, "expected %d digits for %v", e.minWidth, e.field)
+	}
+
+	if positive && e.sign == SignExceedsPad && width <= e.minWidth {
+		return start, failure(start, "unexpected sign for %v", e.field)
+	}
+
+	n, err := strconv.ParseInt(text[pos:pos+width], 10, 64)
+	if err != nil {
+		return start, failure(pos, "%v out of range", e.field)
+	}
+
+	if negative {
+		n = -n
+	}
+
+	if !v.put(e.field, n) {
+		return start, failure(start, "conflicting value for %v", e.field)
+	}
+
+	return pos + width, nil
+}
+
+func (e *reduced) format(b []byte, v *values, _ *symbols) ([]byte, error) {
+	n, ok := v.get(e.field)
+	if !ok {
+		return b, fmt.Errorf("%w: %v", ErrUnsupportedField, e.field)
+	}
+
+	n = (n%100 + 100) % 100
+
+	return append(b, byte('0'+n/10), byte('0'+n%10)), nil
+}
+
+func (e *reduced) parse(text string, pos int, v *values, _ *symbols) (int, error) {
+	if digitsAt(text, pos) < 2 {
+		return pos, failure(pos, "expected 2 digits for %v", e.field)
+	}
+
+	n := e.base + int64(text[pos]-'0')*10 + int64(text[pos+1]-'0')
+	if !v.put(e.field, n) {
+		return pos, failure(pos, "conflicting value for %v", e.field)
+	}
+
+	return pos + 2, nil
+}
+
+func (e *text) format(b []byte, v *values, s *symbols) ([]byte, error) {
+	n, ok := v.get(e.field)
+	if !ok {
+		return b, fmt.Errorf("%w: %v", ErrUnsupportedField, e.field)
+	}
+
+	texts, _ := s.text(e.field, e.style)
+
+	i := n - firstValue(e.field)
+	if i < 0 || i >= int64(len(texts)) {
+		return b, fmt.Errorf("format: %v %d has no text", e.field, n)
+	}
+
+	return append(b, texts[i]...), nil
+}
+
+// parse matches the longest text of the field.
+func (e *text) parse(text string, pos int, v *values, s *symbols) (int, error) {
+	texts, _ := s.text(e.field, e.style)
+
+	match := -1
+	for i, t := range texts {
+		if strings.HasPrefix(text[pos:], t) && (match < 0 || len(t) > len(texts[match])) {
+			match = i
+		}
+	}
+
+	if match < 0 {
+		return pos, failure(pos, "expected text for %v", e.field)
+	}
+
+	if !v.put(e.field, int64(match)+firstValue(e.field)) {
+		return pos, failure(pos, "conflicting value for %v", e.field)
+	}
+
+	return pos + len(texts[match]), nil
+}
+
+func (e *fraction) format(b []byte, v *values, _ *symbols) ([]byte, error) {
+	n, ok := v.get(NanoOfSecond)
+	if !ok {
+		return b, fmt.Errorf("%w: %v", ErrUnsupportedField, NanoOfSecond)
+	}
+
+	digits := fmt.Sprintf("%09d", n)[:e.maxWidth]
+	for len(digits) > e.minWidth && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+	}
+
+	if e.decimalPoint && digits != "" {
+		b = append(b, '.')
+	}
+
+	return append(b, digits...), nil
+}
+
+func (e *fraction) parse(text string, pos int, v *values, _ *symbols) (int, error) {
+	start := pos
+
+	if e.decimalPoint {
+		if pos >= len(text) || text[pos] != '.' {
+			if e.minWidth == 0 {
+				return pos, nil
+			}
+
+			return pos, failure(pos, "expected %q", ".")
+		}
+
+		pos++
+	}
+
+	width := min(e.maxWidth, digitsAt(text, pos))
+	if width < e.minWidth {
+		return start, failure(pos, "expected %d digits for %v", e.minWidth, NanoOfSecond)
+	}
+
+	var n int64
+	for i := range 9 {
+		n *= 10
+		if i < width {
+			n += int64(text[pos+i] - '0')
+		}
+	}
+
+	if !v.put(NanoOfSecond, n) {
+		return start, failure(start, "conflicting value for %v", NanoOfSecond)
+	}
+
+	return pos + width, nil
+}
+
+func (e *offset) format(b []byte, v *values, _ *symbols) ([]byte, error) {
+	if !v.hasOffset {
+		return b, fmt.Errorf("%w: offset", ErrUnsupportedField)
+	}
+
+	if v.offset == 0 && e.noOffsetText != "" {
+		return append(b, e.noOffsetText...), nil
+	}
+
+	sign, secs := byte('+'), v.offset
+	if secs < 0 {
+		sign, secs = '-', -secs
+	}
+
+	hours, minutes, seconds := secs/3600, secs/60%60, secs%60
+
+	b = appendTwoDigits(append(b, sign), hours)
+
+	if e.style.minutes == requiredPart || e.style.minutes == optionalPart && (minutes != 0 || seconds != 0) {
+		b = e.appendPart(b, minutes)
+	}
+
+	if e.style.seconds == requiredPart || e.style.seconds == optionalPart && seconds != 0 {
+		b = e.appendPart(b, seconds)
+	}
+
+	return b, nil
+}
+
+func (e *offset) parse(text string, pos int, v *values, _ *symbols) (int, error) {
+	start := pos
+
+	var secs int
+
+	switch {
+	case e.noOffsetText != "" && strings.HasPrefix(text[pos:], e.noOffsetText):
+		pos += len(e.noOffsetText)
+	case pos < len(text) && (text[pos] == '+' || text[pos] == '-'):
+		hours, ok := twoDigitsAt(text, pos+1)
+		if !ok || hours > 18 {
+			return start, failure(pos+1, "expected offset hours")
+		}
+
+		pos += 3
+
+		minutes, next, err := e.parsePart(text, pos, e.style.minutes, "minutes")
+		if err != nil {
+			return start, err
+		}
+
+		seconds, next, err := e.parsePart(text, next, e.style.seconds, "seconds")
+		if err != nil {
+			return start, err
+		}
+
+		secs = hours*3600 + minutes*60 + seconds
+		if text[start] == '-' {
+			secs = -secs
+		}
+
+		pos = next
+	default:
+		return start, failure(pos, "expected offset")
+	}
+
+	if v.hasOffset && v.offset != secs {
+		return start, failure(start, "conflicting offset")
+	}
+
+	v.offset, v.hasOffset = secs, true
+
+	return pos, nil
+}
+
+func (e *offset) appendPart(b []byte, n int) []byte {
+	if e.style.colon {
+		b = append(b, ':')
+	}
+
+	return appendTwoDigits(b, n)
+}
+
+// parsePart parses the minutes or seconds of an offset, returning 0 if the part is optional and missing.
+func (e *offset) parsePart(text string, pos, part int, name string) (int, int, error) {
+	if part == noPart {
+		return 0, pos, nil
+	}
+
+	next := pos
+	if e.style.colon {
+		if next >= len(text) || text[next] != ':' {
+			if part == optionalPart {
+				return 0, pos, nil
+			}
+
+			return 0, pos, failure(pos, "expected %q", ":")
+		}
+
+		next++
+	}
+
+	n, ok := twoDigitsAt(text, next)
+	if !ok || n > 59 {
+		if part == optionalPart && next == pos {
+			return 0, pos, nil
+		}
+
+		return 0, pos, failure(next, "expected offset %s", name)
+	}
+
+	return n, next + 2, nil
+}
+
+func (zoneID) format(b []byte, v *values, _ *symbols) ([]byte, error) {
+	if v.loc == nil {
+		return b, fmt.Errorf("%w: zone ID", ErrUnsupportedField)
+	}
+
+	return append(b, v.loc.String()...), nil
+}
+
+func (zoneID) parse(text string, pos int, v *values, _ *symbols) (int, error) {
+	end := pos
+	for end < len(text) && isZoneIDChar(text[end]) {
+		end++
+	}
+
+	loc, err := time.LoadLocation(text[pos:end])
+	if end == pos || err != nil {
+		return pos, failure(pos, "expected zone ID")
+	}
+
+	if v.loc != nil && v.loc.String() != loc.String() {
+		return pos, failure(pos, "conflicting zone ID")
+	}
+
+	v.loc = loc
+
+	return end, nil
+}
+
+// format skips the section if any of its fields is not supported.
+func (o optional) format(b []byte, v *values, s *symbols) ([]byte, error) {
+	formatted := b
+
+	for _, e := range o {
+		var err error
+
+		formatted, err = e.format(formatted, v, s)
+		if errors.Is(err, ErrUnsupportedField) {
+			return b, nil
+		}
+
+		if err != nil {
+			return b, err
+		}
+	}
+
+	return formatted, nil
+}
+
+// parse skips the section, and discards its fields, if it does not match.
+func (o optional) parse(text string, pos int, v *values, s *symbols) (int, error) {
+	snapshot := *v
+	next := pos
+
+	for _, e := range o {
+		var err error
+
+		next, err = e.parse(text, next, v, s)
+		if err != nil {
+			*v = snapshot
+
+			return pos, nil
+		}
+	}
+
+	return next, nil
+}
+
+func (p *pad) format(b []byte, v *values, s *symbols) ([]byte, error) {
+	formatted, err := p.element.format(nil, v, s)
+	if err != nil {
+		return b, err
+	}
+
+	length := utf8.RuneCount(formatted)
+	if length > p.width {
+		return b, fmt.Errorf("format: %q exceeds pad width %d", formatted, p.width)
+	}
+
+	for range p.width - length {
+		b = utf8.AppendRune(b, p.char)
+	}
+
+	return append(b, formatted...), nil
+}
+
+// parse parses the element from the next width characters, after skipping the pad characters.
+func (p *pad) parse(text string, pos int, v *values, s *symbols) (int, error) {
+	end := pos
+	for range p.width {
+		if end >= len(text) {
+			return pos, failure(end, "expected %d padded characters", p.width)
+		}
+
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+
+	start := pos
+	for start < end {
+		r, size := utf8.DecodeRuneInString(text[start:])
+		if r != p.char {
+			break
+		}
+
+		start += size
+	}
+
+	next, err := p.element.parse(text[:end], start, v, s)
+	if err != nil {
+		return pos, err
+	}
+
+	if next != end {
+		return pos, failure(next, "unexpected text in padded width")
+	}
+
+	return end, nil
+}
+
+// failure returns the *ParseError at pos, whose Text is filled by the Formatter.
+func failure(pos int, format string, args ...any) *ParseError {
+	return &ParseError{Pos: pos, Reason: fmt.Sprintf(format, args...)}
+}
+
+// firstValue returns the value of the field whose text is the first one.
+func firstValue(field Field) int64 {
+	if field == Era || field == AmPmOfDay {
+		return 0
+	}
+
+	return 1
+}
+
+// digitsAt returns the number of consecutive ASCII digits in text from pos.
+func digitsAt(text string, pos int) int {
+	n := 0
+	for pos+n < len(text) && text[pos+n] >= '0' && text[pos+n] <= '9' {
+		n++
+	}
+
+	return n
+}
+
+func twoDigitsAt(text string, pos int) (int, bool) {
+	if digitsAt(text, pos) < 2 {
+		return 0, false
+	}
+
+	return int(text[pos]-'0')*10 + int(text[pos+1]-'0'), true
+}
+
+func appendTwoDigits(b []byte, n int) []byte {
+	return append(b, byte('0'+n/10), byte('0'+n%10))
+}
+
+func isZoneIDChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.IndexByte("/_+-", c) >= 0
+}
+
+// reserveWidths sets, on every variable width value followed by fixed width values, the number of digits they need,
+// so they can be parsed without separators.
+func reserveWidths(elements []element) {
+	for i, e := range elements {
+		switch e := e.(type) {
+		case *value:
+			if e.minWidth == e.maxWidth {
+				continue
+			}
+
+			e.reserved = 0
+
+			for _, next := range elements[i+1:] {
+				width, ok := fixedWidth(next)
+				if !ok {
+					break
+				}
+
+				e.reserved += width
+			}
+		case optional:
+			reserveWidths(e)
+		}
+	}
+}
+
+// cloneElements returns a deep copy of the elements that can be modified, the *value elements and the ones containing
+// them. The other elements are never modified once created, so they are shared.
+func cloneElements(elements []element) []element {
+	clone := make([]element, len(elements))
+	for i, e := range elements {
+		switch e := e.(type) {
+		case *value:
+			v := *e
+			clone[i] = &v
+		case *pad:
+			p := *e
+			p.element = cloneElements([]element{e.element})[0]
+			clone[i] = &p
+		case optional:
+			clone[i] = optional(cloneElements(e))
+		default:
+			clone[i] = e
+		}
+	}
+
+	return clone
+}
+
+// fixedWidth returns the width of an element that always parses the same number of digits.
+func fixedWidth(e element) (int, bool) {
+	switch e := e.(type) {
+	case *value:
+		return e.minWidth, e.minWidth == e.maxWidth && (e.sign == SignNever || e.sign == SignNotNegative)
+	case *reduced:
+		return 2, true
+	case *fraction:
+		return e.minWidth, e.minWidth == e.maxWidth && !e.decimalPoint
+	default:
+		return 0, false
+	}
+}
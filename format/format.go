@@ -0,0 +1,253 @@
+// Package format formats and parses dates and times using patterns like "dd/MM/yyyy HH:mm:ss.SSS",
+// "EEE, d MMM uuuu" or "uuuu-MM-dd['T'HH:mm]".
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/format/DateTimeFormatter.html.
+package format
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+// ErrUnsupportedField is returned when formatting a value that does not have a field of the pattern, e.g. the hour
+// of a LocalDate.
+var ErrUnsupportedField = errors.New("format: unsupported field")
+
+type (
+	// Formatter formats and parses LocalDate, LocalTime, LocalDateTime and time.Time values.
+	// It is created from a pattern with New, or piece by piece with a Builder, and is safe for concurrent use.
+	// The texts, e.g. the names of the months, are in English unless another locale is chosen with WithLocale.
+	Formatter struct {
+		elements []element
+		locale   string
+		symbols  *symbols
+	}
+
+	// ParseError describes a problem parsing a text.
+	ParseError struct {
+		// Text is the text being parsed.
+		Text string
+		// Pos is the byte offset of the problem in Text, 0 if the fields found do not make a valid value.
+		Pos int
+		// Reason describes the problem.
+		Reason string
+	}
+)
+
+// New returns the Formatter of a pattern, made of the following letters, repeated to choose the width or the style:
+//
+//	G   era                 AD; Anno Domini; A
+//	u   year                2024; 24
+//	y   year of era         2024; 24
+//	Q/q quarter of year     3; 03; Q3; 3rd quarter; 3
+//	M/L month of year       7; 07; Jul; July; J
+//	d   day of month        5; 05
+//	D   day of year         189
+//	E   day of week         Fri; Friday; F
+//	a   am-pm of day        PM
+//	H   hour of day         0-23
+//	k   clock hour of day   1-24
+//	K   hour of am-pm       0-11
+//	h   clock hour of am-pm 1-12
+//	m   minute of hour      30
+//	s   second of minute    55
+//	S   fraction of second  978
+//	n   nano of second      987654321
+//	X   offset, Z for zero  Z; -08; -0830; -08:30; -083015; -08:30:15
+//	x   offset              +00; -08; -0830; -08:30; -083015; -08:30:15
+//	Z   offset              +0000; -08:00, Z for zero with 5 letters
+//	VV  zone ID             Europe/Madrid
+//	p   pad next            the width is the number of letters
+//
+// Text between single quotes is a literal, and two single quotes are a single quote. Brackets delimit optional
+// sections, that can be nested. Any other character that is not a letter is a literal, except '{', '}' and '#',
+// that are reserved.
+// Returns a *PatternError if the pattern is not valid.
+func New(pattern string) (Formatter, error) {
+	return NewBuilder().AppendPattern(pattern).Build()
+}
+
+// Must returns the Formatter of a pattern.
+// Panics if the pattern is not valid.
+func Must(pattern string) Formatter {
+	f, err := New(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return f
+}
+
+// FormatLocalDate formats the LocalDate.
+// Returns an error wrapping ErrUnsupportedField if a mandatory field of the pattern is not a date field.
+func (f Formatter) FormatLocalDate(ld localdate.LocalDate) (string, error) {
+	var v values
+	v.putDate(ld)
+
+	return f.format(&v)
+}
+
+// FormatLocalDateTime formats the LocalDateTime.
+// Returns an error wrapping ErrUnsupportedField if the pattern has a mandatory offset or zone ID.
+func (f Formatter) FormatLocalDateTime(ldt localdatetime.LocalDateTime) (string, error) {
+	var v values
+	v.putDate(ldt.Date())
+	v.putTime(ldt.Time())
+
+	return f.format(&v)
+}
+
+// FormatLocalTime formats the LocalTime.
+// Returns an error wrapping ErrUnsupportedField if a mandatory field of the pattern is not a time field.
+func (f Formatter) FormatLocalTime(lt localtime.LocalTime) (string, error) {
+	var v values
+	v.putTime(lt)
+
+	return f.format(&v)
+}
+
+// FormatTime formats the time.Time, in its location.
+func (f Formatter) FormatTime(t time.Time) (string, error) {
+	var v values
+	v.putZonedTime(t)
+
+	return f.format(&v)
+}
+
+// ParseLocalDate parses a LocalDate, ignoring the time fields.
+// Returns a *ParseError if the text does not match the pattern, or the date fields do not make a valid date.
+func (f Formatter) ParseLocalDate(s string) (localdate.LocalDate, error) {
+	v, err := f.parse(s)
+	if err != nil {
+		return localdate.LocalDate{}, err
+	}
+
+	ld, err := v.date()
+	if err != nil {
+		return localdate.LocalDate{}, &ParseError{Text: s, Reason: err.Error()}
+	}
+
+	return ld, nil
+}
+
+// ParseLocalDateTime parses a LocalDateTime, the minutes, seconds and nanoseconds being 0 if missing.
+// Returns a *ParseError if the text does not match the pattern, or the fields do not make a valid date and time.
+func (f Formatter) ParseLocalDateTime(s string) (localdatetime.LocalDateTime, error) {
+	v, err := f.parse(s)
+	if err != nil {
+		return localdatetime.LocalDateTime{}, err
+	}
+
+	ld, err := v.date()
+	if err != nil {
+		return localdatetime.LocalDateTime{}, &ParseError{Text: s, Reason: err.Error()}
+	}
+
+	lt, err := v.time()
+	if err != nil {
+		return localdatetime.LocalDateTime{}, &ParseError{Text: s, Reason: err.Error()}
+	}
+
+	return localdatetime.NewFrom(ld, lt), nil
+}
+
+// ParseLocalTime parses a LocalTime, ignoring the date fields, the minutes, seconds and nanoseconds being 0 if missing.
+// Returns a *ParseError if the text does not match the pattern, or the time fields do not make a valid time.
+func (f Formatter) ParseLocalTime(s string) (localtime.LocalTime, error) {
+	v, err := f.parse(s)
+	if err != nil {
+		return localtime.LocalTime{}, err
+	}
+
+	lt, err := v.time()
+	if err != nil {
+		return localtime.LocalTime{}, &ParseError{Text: s, Reason: err.Error()}
+	}
+
+	return lt, nil
+}
+
+// ParseTime parses a time.Time, being midnight if there is no time field.
+// The time is in the parsed zone ID, or at the parsed offset, or else in loc.
+// Returns a *ParseError if the text does not match the pattern, or the fields do not make a valid time.
+func (f Formatter) ParseTime(s string, loc *time.Location) (time.Time, error) {
+	v, err := f.parse(s)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ld, err := v.date()
+	if err != nil {
+		return time.Time{}, &ParseError{Text: s, Reason: err.Error()}
+	}
+
+	var lt localtime.LocalTime
+	if v.hasTime() {
+		if lt, err = v.time(); err != nil {
+			return time.Time{}, &ParseError{Text: s, Reason: err.Error()}
+		}
+	}
+
+	switch {
+	case v.hasOffset:
+		t := lt.ToTime(ld, time.FixedZone("", v.offset))
+		if v.loc != nil {
+			t = t.In(v.loc)
+		}
+
+		return t, nil
+	case v.loc != nil:
+		return lt.ToTime(ld, v.loc), nil
+	default:
+		return lt.ToTime(ld, loc), nil
+	}
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("format: parsing %q at position %d: %s", e.Text, e.Pos, e.Reason)
+}
+
+func (f Formatter) format(v *values) (string, error) {
+	var (
+		b   []byte
+		err error
+	)
+
+	for _, e := range f.elements {
+		if b, err = e.format(b, v, f.symbols); err != nil {
+			return "", err
+		}
+	}
+
+	return string(b), nil
+}
+
+func (f Formatter) parse(s string) (*values, error) {
+	var v values
+
+	pos := 0
+
+	for _, e := range f.elements {
+		var err error
+
+		pos, err = e.parse(s, pos, &v, f.symbols)
+		if err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				pe.Text = s
+			}
+
+			return nil, err
+		}
+	}
+
+	if pos != len(s) {
+		return nil, &ParseError{Text: s, Pos: pos, Reason: "unexpected text after the pattern"}
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,511 @@
+package format
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+func TestFormatLocalDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		ld      localdate.LocalDate
+		want    string
+	}{
+		"Day month year": {
+			pattern: "dd/MM/yyyy",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "05/03/2024",
+		},
+		"Texts": {
+			pattern: "EEE, d MMM uuuu",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "Tue, 5 Mar 2024",
+		},
+		"Full texts": {
+			pattern: "EEEE d MMMM uuuu G",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "Tuesday 5 March 2024 AD",
+		},
+		"Narrow texts": {
+			pattern: "EEEEE MMMMM GGGGG",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "T M A",
+		},
+		"Year of era before Christ": {
+			pattern: "y GGGG",
+			ld:      localdate.New(-43, time.March, 15),
+			want:    "44 Before Christ",
+		},
+		"Negative proleptic year": {
+			pattern: "uuuu-MM-dd",
+			ld:      localdate.New(-43, time.March, 15),
+			want:    "-0043-03-15",
+		},
+		"Year exceeding the pad": {
+			pattern: "uuuu-MM-dd",
+			ld:      localdate.New(12024, time.March, 15),
+			want:    "+12024-03-15",
+		},
+		"Reduced year": {
+			pattern: "d/M/yy",
+			ld:      localdate.New(1999, time.December, 31),
+			want:    "31/12/99",
+		},
+		"Day of year and quarter": {
+			pattern: "uuuu-DDD QQQ QQQQ q",
+			ld:      localdate.New(2024, time.December, 31),
+			want:    "2024-366 Q4 4th quarter 4",
+		},
+		"Quoted literal": {
+			pattern: "d 'de' MMMM 'o''clock'",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "5 de March o'clock",
+		},
+		"Optional section skipped": {
+			pattern: "uuuu-MM-dd['T'HH:mm]",
+			ld:      localdate.New(2024, time.March, 5),
+			want:    "2024-03-05",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).FormatLocalDate(test.ld)
+			if err != nil || got != test.want {
+				t.Errorf("FormatLocalDate = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatLocalTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		lt      localtime.LocalTime
+		want    string
+	}{
+		"Hours, minutes, seconds and millis": {
+			pattern: "HH:mm:ss.SSS",
+			lt:      localtime.New(9, 5, 7, 123_456_789),
+			want:    "09:05:07.123",
+		},
+		"Clock hour of am-pm": {
+			pattern: "h:mm a",
+			lt:      localtime.New(0, 30, 0, 0),
+			want:    "12:30 AM",
+		},
+		"Hour of am-pm": {
+			pattern: "K:mm a",
+			lt:      localtime.New(12, 30, 0, 0),
+			want:    "0:30 PM",
+		},
+		"Clock hour of day": {
+			pattern: "kk:mm",
+			lt:      localtime.New(0, 15, 0, 0),
+			want:    "24:15",
+		},
+		"Nano of second": {
+			pattern: "ss n",
+			lt:      localtime.New(0, 0, 1, 5000),
+			want:    "01 5000",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).FormatLocalTime(test.lt)
+			if err != nil || got != test.want {
+				t.Errorf("FormatLocalTime = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatLocalDateTime(t *testing.T) {
+	t.Parallel()
+
+	ldt := localdatetime.New(2024, time.March, 5, 18, 45, 30, 500_000_000)
+
+	got, err := Must("dd/MM/yyyy HH:mm:ss.SSS[XXX]").FormatLocalDateTime(ldt)
+	if want := "05/03/2024 18:45:30.500"; err != nil || got != want {
+		t.Errorf("FormatLocalDateTime = %q, %v, want %q", got, err, want)
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	t.Parallel()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := map[string]struct {
+		pattern string
+		t       time.Time
+		want    string
+	}{
+		"Offset Z": {
+			pattern: "uuuu-MM-dd'T'HH:mm:ssXXX",
+			t:       time.Date(2024, time.March, 5, 18, 45, 30, 0, time.UTC),
+			want:    "2024-03-05T18:45:30Z",
+		},
+		"Offset zero": {
+			pattern: "HH:mm xx",
+			t:       time.Date(2024, time.March, 5, 18, 45, 30, 0, time.UTC),
+			want:    "18:45 +0000",
+		},
+		"Summer time": {
+			pattern: "uuuu-MM-dd HH:mm Z VV",
+			t:       time.Date(2024, time.July, 5, 18, 45, 30, 0, madrid),
+			want:    "2024-07-05 18:45 +0200 Europe/Madrid",
+		},
+		"Offset with optional minutes": {
+			pattern: "HH:mm X",
+			t:       time.Date(2024, time.July, 5, 18, 45, 30, 0, time.FixedZone("", -(5*3600+30*60))),
+			want:    "18:45 -0530",
+		},
+		"Offset without minutes": {
+			pattern: "HH:mm X",
+			t:       time.Date(2024, time.July, 5, 18, 45, 30, 0, madrid),
+			want:    "18:45 +02",
+		},
+		"Offset with seconds": {
+			pattern: "XXXXX",
+			t:       time.Date(2024, time.July, 5, 18, 45, 30, 0, time.FixedZone("", 3600+1)),
+			want:    "+01:00:01",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).FormatTime(test.t)
+			if err != nil || got != test.want {
+				t.Errorf("FormatTime = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		format  func(f Formatter) (string, error)
+		want    error
+	}{
+		"Time of a LocalDate": {
+			pattern: "uuuu-MM-dd HH:mm",
+			format: func(f Formatter) (string, error) {
+				return f.FormatLocalDate(localdate.New(2024, time.March, 5))
+			},
+			want: ErrUnsupportedField,
+		},
+		"Date of a LocalTime": {
+			pattern: "HH:mm d",
+			format: func(f Formatter) (string, error) {
+				return f.FormatLocalTime(localtime.New(10, 0, 0, 0))
+			},
+			want: ErrUnsupportedField,
+		},
+		"Offset of a LocalDateTime": {
+			pattern: "uuuu-MM-dd HH:mm X",
+			format: func(f Formatter) (string, error) {
+				return f.FormatLocalDateTime(localdatetime.New(2024, time.March, 5, 10, 0, 0, 0))
+			},
+			want: ErrUnsupportedField,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.format(Must(test.pattern)); !errors.Is(err, test.want) {
+				t.Errorf("format error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		value   string
+		want    localdate.LocalDate
+	}{
+		"Day month year": {
+			pattern: "dd/MM/yyyy",
+			value:   "05/03/2024",
+			want:    localdate.New(2024, time.March, 5),
+		},
+		"Texts": {
+			pattern: "EEE, d MMM uuuu",
+			value:   "Tue, 5 Mar 2024",
+			want:    localdate.New(2024, time.March, 5),
+		},
+		"Longest text": {
+			pattern: "MMMM d, uuuu",
+			value:   "June 30, 2024",
+			want:    localdate.New(2024, time.June, 30),
+		},
+		"Adjacent values": {
+			pattern: "uuuuMMdd",
+			value:   "20240305",
+			want:    localdate.New(2024, time.March, 5),
+		},
+		"Adjacent values with a variable width first": {
+			pattern: "uMMdd",
+			value:   "120240305",
+			want:    localdate.New(12024, time.March, 5),
+		},
+		"Year exceeding the pad": {
+			pattern: "uuuu-MM-dd",
+			value:   "+12024-03-05",
+			want:    localdate.New(12024, time.March, 5),
+		},
+		"Negative year": {
+			pattern: "uuuu-MM-dd",
+			value:   "-0043-03-15",
+			want:    localdate.New(-43, time.March, 15),
+		},
+		"Year of era before Christ": {
+			pattern: "d/M/y G",
+			value:   "15/3/44 BC",
+			want:    localdate.New(-43, time.March, 15),
+		},
+		"Reduced year": {
+			pattern: "d/M/yy",
+			value:   "31/12/99",
+			want:    localdate.New(2099, time.December, 31),
+		},
+		"Day of year": {
+			pattern: "uuuu-DDD",
+			value:   "2024-366",
+			want:    localdate.New(2024, time.December, 31),
+		},
+		"Time fields ignored": {
+			pattern: "uuuu-MM-dd HH:mm",
+			value:   "2024-03-05 10:30",
+			want:    localdate.New(2024, time.March, 5),
+		},
+		"Optional section missing": {
+			pattern: "uuuu-MM[-dd]['T'HH:mm] DDD",
+			value:   "2024-03 065",
+			want:    localdate.New(2024, time.March, 5),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).ParseLocalDate(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("ParseLocalDate = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		value   string
+		want    localtime.LocalTime
+	}{
+		"Hours, minutes, seconds and millis": {
+			pattern: "HH:mm:ss.SSS",
+			value:   "09:05:07.123",
+			want:    localtime.New(9, 5, 7, 123_000_000),
+		},
+		"Missing minutes": {
+			pattern: "HH",
+			value:   "09",
+			want:    localtime.New(9, 0, 0, 0),
+		},
+		"Clock hour of am-pm": {
+			pattern: "h:mm a",
+			value:   "12:30 AM",
+			want:    localtime.New(0, 30, 0, 0),
+		},
+		"Afternoon": {
+			pattern: "h:mm a",
+			value:   "1:30 PM",
+			want:    localtime.New(13, 30, 0, 0),
+		},
+		"Clock hour of day": {
+			pattern: "kk:mm",
+			value:   "24:15",
+			want:    localtime.New(0, 15, 0, 0),
+		},
+		"Adjacent values": {
+			pattern: "HHmmssSSS",
+			value:   "090507123",
+			want:    localtime.New(9, 5, 7, 123_000_000),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).ParseLocalTime(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("ParseLocalTime = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseLocalDateTime(t *testing.T) {
+	t.Parallel()
+
+	got, err := Must("dd/MM/yyyy HH:mm:ss.SSS").ParseLocalDateTime("05/03/2024 18:45:30.500")
+	if want := localdatetime.New(2024, time.March, 5, 18, 45, 30, 500_000_000); err != nil || got != want {
+		t.Errorf("ParseLocalDateTime = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	tests := map[string]struct {
+		pattern string
+		value   string
+		want    time.Time
+	}{
+		"Offset Z": {
+			pattern: "uuuu-MM-dd'T'HH:mm:ssXXX",
+			value:   "2024-03-05T18:45:30Z",
+			want:    time.Date(2024, time.March, 5, 18, 45, 30, 0, time.UTC),
+		},
+		"Offset": {
+			pattern: "uuuu-MM-dd'T'HH:mmXXX",
+			value:   "2024-03-05T18:45-05:30",
+			want:    time.Date(2024, time.March, 6, 0, 15, 0, 0, time.UTC),
+		},
+		"Zone ID": {
+			pattern: "uuuu-MM-dd HH:mm VV",
+			value:   "2024-07-05 18:45 Europe/Madrid",
+			want:    time.Date(2024, time.July, 5, 18, 45, 0, 0, madrid),
+		},
+		"Default location": {
+			pattern: "uuuu-MM-dd HH:mm",
+			value:   "2024-07-05 18:45",
+			want:    time.Date(2024, time.July, 5, 18, 45, 0, 0, madrid),
+		},
+		"Missing time is midnight": {
+			pattern: "uuuu-MM-dd",
+			value:   "2024-07-05",
+			want:    time.Date(2024, time.July, 5, 0, 0, 0, 0, madrid),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).ParseTime(test.value, madrid)
+			if err != nil || !got.Equal(test.want) {
+				t.Errorf("ParseTime = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		value   string
+		want    ParseError
+	}{
+		"Missing literal": {
+			pattern: "dd/MM/yyyy",
+			value:   "05-03-2024",
+			want:    ParseError{Pos: 2, Reason: `expected "/"`},
+		},
+		"Missing digits": {
+			pattern: "dd/MM/yyyy",
+			value:   "05/3/2024",
+			want:    ParseError{Pos: 3, Reason: "expected 2 digits for MonthOfYear"},
+		},
+		"Unknown text": {
+			pattern: "d MMM uuuu",
+			value:   "5 Mars 2024",
+			want:    ParseError{Pos: 5, Reason: `expected " "`},
+		},
+		"Trailing text": {
+			pattern: "uuuu-MM-dd",
+			value:   "2024-03-05T10:00",
+			want:    ParseError{Pos: 10, Reason: "unexpected text after the pattern"},
+		},
+		"Invalid date": {
+			pattern: "uuuu-MM-dd",
+			value:   "2023-02-29",
+			want:    ParseError{Reason: "localdate: day 29 out of range [1, 28]"},
+		},
+		"Out of range month": {
+			pattern: "uuuu-MM-dd",
+			value:   "2024-13-01",
+			want:    ParseError{Reason: "MonthOfYear 13 out of range [1, 12]"},
+		},
+		"Wrong day of week": {
+			pattern: "EEE, d MMM uuuu",
+			value:   "Wed, 5 Mar 2024",
+			want:    ParseError{Reason: "DayOfWeek 3 does not match 2024-03-05"},
+		},
+		"Missing day": {
+			pattern: "uuuu-MM",
+			value:   "2024-03",
+			want:    ParseError{Reason: "missing field DayOfMonth"},
+		},
+		"Unexpected sign": {
+			pattern: "uuuu-MM-dd",
+			value:   "+2024-03-05",
+			want:    ParseError{Reason: "unexpected sign for Year"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Must(test.pattern).ParseLocalDate(test.value)
+
+			test.want.Text = test.value
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || *parseErr != test.want {
+				t.Errorf("ParseLocalDate error = %v, want %v", err, &test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package format
+
+import (
+	"bytes"
+	"cmp"
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// defaultLocale is the locale of the formatters returned by New and Builder.Build.
+const defaultLocale = "en"
+
+// ErrUnknownLocale is returned by WithLocale for a locale without texts.
+var ErrUnknownLocale = errors.New("format: unknown locale")
+
+var (
+	// localeFiles contains the texts of each locale, derived from the Unicode CLDR gregorian calendar data.
+	// The weekdays start on Monday, and the eras before Christ.
+	//
+	//go:embed locales/*.json
+	localeFiles embed.FS
+
+	// loadedLocales caches the *symbols of each locale already loaded.
+	loadedLocales sync.Map //nolint:gochecknoglobals // cache of the embedded files.
+)
+
+type (
+	// localeData is the content of a locale file.
+	localeData struct {
+		Months     localeTexts  `json:"months"`
+		Weekdays   localeTexts  `json:"weekdays"`
+		Quarters   localeTexts  `json:"quarters"`
+		DayPeriods localeTexts  `json:"dayPeriods"`
+		Eras       localeWidths `json:"eras"`
+	}
+
+	// localeTexts are the texts of a field, the standalone ones defaulting to the format ones.
+	localeTexts struct {
+		localeWidths
+
+		Standalone localeWidths `json:"standalone"`
+	}
+
+	localeWidths struct {
+		Wide        []string `json:"wide"`
+		Abbreviated []string `json:"abbreviated"`
+		Narrow      []string `json:"narrow"`
+	}
+)
+
+// Locales returns the locales supported by WithLocale, e.g. "en", "es" or "ja".
+func Locales() []string {
+	entries, _ := localeFiles.ReadDir("locales")
+
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		locales = append(locales, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+
+	return locales
+}
+
+// Locale returns the locale of the texts of the formatter, "en" by default.
+func (f Formatter) Locale() string {
+	return cmp.Or(f.locale, defaultLocale)
+}
+
+// WithLocale returns a copy of the formatter using the texts of the locale, e.g. "es" or "es-ES", to format and parse
+// the months, the days of the week, the quarters, the am-pm markers and the eras.
+// A locale with a region falls back to its language, e.g. "pt_BR" to "pt".
+// Returns an error wrapping ErrUnknownLocale if the locale is not one of Locales.
+func (f Formatter) WithLocale(locale string) (Formatter, error) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	language, _, _ := strings.Cut(tag, "-")
+
+	locales := Locales()
+
+	for _, candidate := range []string{tag, language} {
+		if !slices.Contains(locales, candidate) {
+			continue
+		}
+
+		s, err := loadLocale(candidate)
+		if err != nil {
+			return Formatter{}, err
+		}
+
+		f.locale, f.symbols = candidate, s
+
+		return f, nil
+	}
+
+	return Formatter{}, fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+}
+
+// english returns the symbols of the default locale.
+// Panics if the embedded file is not valid, which is checked by the tests.
+func english() *symbols {
+	s, err := loadLocale(defaultLocale)
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+// loadLocale returns the symbols of the embedded locale file.
+func loadLocale(locale string) (*symbols, error) {
+	if s, ok := loadedLocales.Load(locale); ok {
+		return s.(*symbols), nil //nolint:forcetypeassert // the cache only contains *symbols.
+	}
+
+	data, err := localeFiles.ReadFile("locales/" + locale + ".json")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLocale, locale)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var ld localeData
+	if err = decoder.Decode(&ld); err != nil {
+		return nil, fmt.Errorf("format: locale %q: %w", locale, err)
+	}
+
+	s, err := ld.symbols()
+	if err != nil {
+		return nil, fmt.Errorf("format: locale %q: %w", locale, err)
+	}
+
+	loaded, _ := loadedLocales.LoadOrStore(locale, s)
+
+	return loaded.(*symbols), nil //nolint:forcetypeassert // the cache only contains *symbols.
+}
+
+func (d *localeData) symbols() (*symbols, error) {
+	s := &symbols{}
+
+	for style := range TextStyle(textStyleCount) {
+		err := errors.Join(
+			fill(s.months[style][:], d.Months.get(style), "months"),
+			fill(s.weekdays[style][:], d.Weekdays.get(style), "weekdays"),
+			fill(s.quarters[style][:], d.Quarters.get(style), "quarters"),
+			fill(s.amPm[style][:], d.DayPeriods.get(style), "dayPeriods"),
+			fill(s.eras[style][:], d.Eras.get(style), "eras"),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+func (t localeTexts) get(style TextStyle) []string {
+	if style >= TextFullStandalone {
+		if texts := t.Standalone.get(style); texts != nil {
+			return texts
+		}
+	}
+
+	return t.localeWidths.get(style)
+}
+
+func (w localeWidths) get(style TextStyle) []string {
+	switch style.base() {
+	case TextFull:
+		return w.Wide
+	case TextShort:
+		return w.Abbreviated
+	default:
+		return w.Narrow
+	}
+}
+
+// fill copies the texts of a field, that must be as many as its values.
+func fill(dst, texts []string, name string) error {
+	if len(texts) != len(dst) || slices.Contains(texts, "") {
+		return fmt.Errorf("%s: %d texts, want %d non empty texts", name, len(texts), len(dst))
+	}
+
+	copy(dst, texts)
+
+	return nil
+}
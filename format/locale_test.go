@@ -0,0 +1,191 @@
+package format
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+)
+
+func TestLocales(t *testing.T) {
+	t.Parallel()
+
+	locales := Locales()
+	if len(locales) < 20 {
+		t.Errorf("Locales = %v, want at least 20 locales", locales)
+	}
+
+	for _, locale := range locales {
+		if _, err := loadLocale(locale); err != nil {
+			t.Errorf("loadLocale(%q) error = %v", locale, err)
+		}
+	}
+}
+
+func TestWithLocaleFormatLocalDate(t *testing.T) {
+	t.Parallel()
+
+	ld := localdate.New(2024, time.March, 5)
+
+	tests := map[string]struct {
+		locale  string
+		pattern string
+		want    string
+	}{
+		"Spanish": {
+			locale:  "es",
+			pattern: "EEEE, d 'de' MMMM 'de' uuuu",
+			want:    "martes, 5 de marzo de 2024",
+		},
+		"Spanish with region": {
+			locale:  "es-ES",
+			pattern: "EEE d MMM",
+			want:    "mar 5 mar",
+		},
+		"French": {
+			locale:  "fr",
+			pattern: "EEEE d MMMM uuuu",
+			want:    "mardi 5 mars 2024",
+		},
+		"German standalone short month": {
+			locale:  "de",
+			pattern: "d. MMM uuuu, LLL",
+			want:    "5. März 2024, Mär",
+		},
+		"Russian format and standalone month": {
+			locale:  "ru",
+			pattern: "d MMMM uuuu, LLLL",
+			want:    "5 марта 2024, март",
+		},
+		"Catalan": {
+			locale:  "ca",
+			pattern: "EEEE, d MMMM 'de' uuuu",
+			want:    "dimarts, 5 de març de 2024",
+		},
+		"Japanese": {
+			locale:  "ja",
+			pattern: "uuuu年MMMd日 EEEE",
+			want:    "2024年3月5日 火曜日",
+		},
+		"Era and quarter": {
+			locale:  "it",
+			pattern: "QQQQ uuuu G",
+			want:    "1º trimestre 2024 d.C.",
+		},
+		"Portuguese with underscore region": {
+			locale:  "pt_BR",
+			pattern: "EEEE, d 'de' MMMM",
+			want:    "terça-feira, 5 de março",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := Must(test.pattern).WithLocale(test.locale)
+			if err != nil {
+				t.Fatalf("WithLocale error = %v", err)
+			}
+
+			got, err := f.FormatLocalDate(ld)
+			if err != nil || got != test.want {
+				t.Errorf("FormatLocalDate = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestWithLocaleParseLocalDateTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		locale  string
+		pattern string
+		value   string
+		want    localdatetime.LocalDateTime
+	}{
+		"Spanish": {
+			locale:  "es",
+			pattern: "EEEE, d 'de' MMMM 'de' uuuu, h:mm a",
+			value:   "martes, 5 de marzo de 2024, 6:45 p. m.",
+			want:    localdatetime.New(2024, time.March, 5, 18, 45, 0, 0),
+		},
+		"Polish genitive month": {
+			locale:  "pl",
+			pattern: "d MMMM uuuu HH:mm",
+			value:   "5 marca 2024 18:45",
+			want:    localdatetime.New(2024, time.March, 5, 18, 45, 0, 0),
+		},
+		"Greek": {
+			locale:  "el",
+			pattern: "EEEE, d MMMM uuuu h:mm a",
+			value:   "Τρίτη, 5 Μαρτίου 2024 6:45 μ.μ.",
+			want:    localdatetime.New(2024, time.March, 5, 18, 45, 0, 0),
+		},
+		"Korean": {
+			locale:  "ko",
+			pattern: "uuuu년 MMMM d일 EEEE a h:mm",
+			value:   "2024년 3월 5일 화요일 오후 6:45",
+			want:    localdatetime.New(2024, time.March, 5, 18, 45, 0, 0),
+		},
+		"Longest month text": {
+			locale:  "zh",
+			pattern: "uuuu MMMM d a h:mm",
+			value:   "2024 十一月 5 下午 6:45",
+			want:    localdatetime.New(2024, time.November, 5, 18, 45, 0, 0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := Must(test.pattern).WithLocale(test.locale)
+			if err != nil {
+				t.Fatalf("WithLocale error = %v", err)
+			}
+
+			got, err := f.ParseLocalDateTime(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("ParseLocalDateTime = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestWithLocaleError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Unknown language": "xx",
+		"Empty":            "",
+		"Path":             "../en",
+	}
+
+	for name, locale := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Must("d MMMM").WithLocale(locale); !errors.Is(err, ErrUnknownLocale) {
+				t.Errorf("WithLocale error = %v, want %v", err, ErrUnknownLocale)
+			}
+		})
+	}
+}
+
+func TestLocale(t *testing.T) {
+	t.Parallel()
+
+	f := Must("d MMMM")
+	if got := f.Locale(); got != "en" {
+		t.Errorf("Locale = %q, want %q", got, "en")
+	}
+
+	es, err := f.WithLocale("es-MX")
+	if err != nil || es.Locale() != "es" || f.Locale() != "en" {
+		t.Errorf("WithLocale = %q, %v, want %q without changing %q", es.Locale(), err, "es", f.Locale())
+	}
+}
@@ -0,0 +1,260 @@
+package format
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+//nolint:gochecknoglobals // table of fields, like a constant.
+var letterFields = map[byte]Field{
+	'd': DayOfMonth,
+	'H': HourOfDay,
+	'k': ClockHourOfDay,
+	'K': HourOfAmPm,
+	'h': ClockHourOfAmPm,
+	'm': MinuteOfHour,
+	's': SecondOfMinute,
+}
+
+//nolint:gochecknoglobals // table of patterns, like a constant.
+var offsetPatterns = [...]string{"+HHmm", "+HHMM", "+HH:MM", "+HHMMss", "+HH:MM:ss"}
+
+// PatternError describes a problem in a pattern.
+type PatternError struct {
+	// Pattern is the pattern being compiled.
+	Pattern string
+	// Pos is the byte offset of the problem in Pattern.
+	Pos int
+	// Reason describes the problem.
+	Reason string
+}
+
+func (e *PatternError) Error() string {
+	return fmt.Sprintf("format: pattern %q at position %d: %s", e.Pattern, e.Pos, e.Reason)
+}
+
+// appendPattern appends the elements of the pattern to the Builder.
+func appendPattern(b *Builder, pattern string) error {
+	depth := 0
+
+	for pos := 0; pos < len(pattern); {
+		c := pattern[pos]
+
+		switch {
+		case isLetter(c):
+			count := 1
+			for pos+count < len(pattern) && pattern[pos+count] == c {
+				count++
+			}
+
+			if reason := appendLetter(b, c, count); reason != "" {
+				return &PatternError{Pattern: pattern, Pos: pos, Reason: reason}
+			}
+
+			pos += count
+		case c == '\'':
+			text, next, ok := quoted(pattern, pos)
+			if !ok {
+				return &PatternError{Pattern: pattern, Pos: pos, Reason: "unterminated quoted literal"}
+			}
+
+			b.AppendLiteral(text)
+
+			pos = next
+		case c == '[':
+			b.OptionalStart()
+
+			depth++
+			pos++
+		case c == ']':
+			if depth == 0 {
+				return &PatternError{Pattern: pattern, Pos: pos, Reason: "optional section ended without being started"}
+			}
+
+			b.OptionalEnd()
+
+			depth--
+			pos++
+		case c == '{' || c == '}' || c == '#':
+			return &PatternError{Pattern: pattern, Pos: pos, Reason: fmt.Sprintf("reserved character %q", c)}
+		default:
+			_, size := utf8.DecodeRuneInString(pattern[pos:])
+			b.AppendLiteral(pattern[pos : pos+size])
+
+			pos += size
+		}
+	}
+
+	if depth > 0 {
+		return &PatternError{Pattern: pattern, Pos: len(pattern), Reason: "optional section not ended"}
+	}
+
+	return nil
+}
+
+// appendLetter appends the element of a pattern letter repeated count times, returning the reason if it is invalid.
+//
+//nolint:gocognit,gocyclo,cyclop,funlen // one case per pattern letter.
+func appendLetter(b *Builder, c byte, count int) string {
+	tooMany := fmt.Sprintf("too many pattern letters %q", c)
+
+	switch c {
+	case 'p':
+		b.PadNext(count, ' ')
+	case 'G':
+		if count > 5 {
+			return tooMany
+		}
+
+		b.AppendText(Era, textStyle(count, false))
+	case 'u', 'y':
+		field := Year
+		if c == 'y' {
+			field = YearOfEra
+		}
+
+		switch {
+		case count == 1:
+			b.AppendValue(field, 1, 19, SignNormal)
+		case count == 2:
+			b.append(&reduced{field: field, base: 2000})
+		case count == 3:
+			b.AppendValue(field, 3, 19, SignNormal)
+		case count <= 19:
+			b.AppendValue(field, count, 19, SignExceedsPad)
+		default:
+			return tooMany
+		}
+	case 'M', 'L', 'Q', 'q':
+		field := MonthOfYear
+		if c == 'Q' || c == 'q' {
+			field = QuarterOfYear
+		}
+
+		switch {
+		case count <= 2:
+			b.AppendValue(field, count, 2, SignNotNegative)
+		case count <= 5:
+			b.AppendText(field, textStyle(count, c == 'L' || c == 'q'))
+		default:
+			return tooMany
+		}
+	case 'E':
+		if count > 5 {
+			return tooMany
+		}
+
+		b.AppendText(DayOfWeek, textStyle(count, false))
+	case 'a':
+		if count > 1 {
+			return tooMany
+		}
+
+		b.AppendText(AmPmOfDay, TextShort)
+	case 'd', 'H', 'k', 'K', 'h', 'm', 's':
+		if count > 2 {
+			return tooMany
+		}
+
+		b.AppendValue(letterFields[c], count, 2, SignNotNegative)
+	case 'D':
+		if count > 3 {
+			return tooMany
+		}
+
+		b.AppendValue(DayOfYear, count, 3, SignNotNegative)
+	case 'S':
+		if count > 9 {
+			return tooMany
+		}
+
+		b.AppendFraction(count, count, false)
+	case 'n':
+		if count > 19 {
+			return tooMany
+		}
+
+		b.AppendValue(NanoOfSecond, count, 19, SignNotNegative)
+	case 'X', 'x':
+		if count > 5 {
+			return tooMany
+		}
+
+		noOffsetText := ""
+		if c == 'X' {
+			noOffsetText = "Z"
+		}
+
+		b.AppendOffset(offsetPatterns[count-1], noOffsetText)
+	case 'Z':
+		switch {
+		case count <= 3:
+			b.AppendOffset("+HHMM", "")
+		case count == 5:
+			b.AppendOffset("+HH:MM:ss", "Z")
+		default:
+			return fmt.Sprintf("unsupported pattern letters %q", "ZZZZ")
+		}
+	case 'V':
+		if count != 2 {
+			return fmt.Sprintf("pattern letter %q must be repeated twice", c)
+		}
+
+		b.AppendZoneID()
+	default:
+		return fmt.Sprintf("unknown pattern letter %q", c)
+	}
+
+	return ""
+}
+
+// textStyle returns the style of a text pattern letter repeated count times: short up to 3, full for 4, and narrow
+// for 5.
+func textStyle(count int, standalone bool) TextStyle {
+	style := TextShort
+
+	switch count {
+	case 4:
+		style = TextFull
+	case 5:
+		style = TextNarrow
+	}
+
+	if standalone {
+		style += TextFullStandalone
+	}
+
+	return style
+}
+
+// quoted returns the literal quoted at pos, where two single quotes are a single quote, and the position after it.
+func quoted(pattern string, pos int) (string, int, bool) {
+	if pos+1 < len(pattern) && pattern[pos+1] == '\'' {
+		return "'", pos + 2, true
+	}
+
+	var text []byte
+
+	for i := pos + 1; i < len(pattern); i++ {
+		if pattern[i] != '\'' {
+			text = append(text, pattern[i])
+
+			continue
+		}
+
+		if i+1 < len(pattern) && pattern[i+1] == '\'' {
+			text = append(text, '\'')
+			i++
+
+			continue
+		}
+
+		return string(text), i + 1, true
+	}
+
+	return "", pos, false
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
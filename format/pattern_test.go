@@ -0,0 +1,103 @@
+package format
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+func TestNewPatternError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		want    PatternError
+	}{
+		"Unknown letter": {
+			pattern: "uuuu-MM-dd W",
+			want:    PatternError{Pos: 11, Reason: `unknown pattern letter 'W'`},
+		},
+		"Too many letters": {
+			pattern: "ddd/MM",
+			want:    PatternError{Pos: 0, Reason: `too many pattern letters 'd'`},
+		},
+		"Unterminated quote": {
+			pattern: "HH 'h",
+			want:    PatternError{Pos: 3, Reason: "unterminated quoted literal"},
+		},
+		"Optional not started": {
+			pattern: "HH]",
+			want:    PatternError{Pos: 2, Reason: "optional section ended without being started"},
+		},
+		"Optional not ended": {
+			pattern: "HH[:mm",
+			want:    PatternError{Pos: 6, Reason: "optional section not ended"},
+		},
+		"Reserved character": {
+			pattern: "HH#",
+			want:    PatternError{Pos: 2, Reason: `reserved character '#'`},
+		},
+		"Single zone ID letter": {
+			pattern: "V",
+			want:    PatternError{Pos: 0, Reason: `pattern letter 'V' must be repeated twice`},
+		},
+		"Localized offset": {
+			pattern: "ZZZZ",
+			want:    PatternError{Pos: 0, Reason: `unsupported pattern letters "ZZZZ"`},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := New(test.pattern)
+
+			test.want.Pattern = test.pattern
+
+			var patternErr *PatternError
+			if !errors.As(err, &patternErr) || *patternErr != test.want {
+				t.Errorf("New error = %v, want %v", err, &test.want)
+			}
+		})
+	}
+}
+
+func TestMustPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Must did not panic")
+		}
+	}()
+
+	Must("uuuu-MM-dd W")
+}
+
+func TestQuoted(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		pattern string
+		want    string
+	}{
+		"Literal":               {pattern: "'at'", want: "at"},
+		"Single quote":          {pattern: "''", want: "'"},
+		"Quote inside literal":  {pattern: "'o''clock'", want: "o'clock"},
+		"Letters are literals":  {pattern: "'uuuu-MM-dd'", want: "uuuu-MM-dd"},
+		"Non letter characters": {pattern: "- /:.,é", want: "- /:.,é"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Must(test.pattern).FormatLocalTime(localtime.LocalTime{})
+			if err != nil || got != test.want {
+				t.Errorf("Format = %q, %v, want %q", got, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package format
+
+// symbols are the texts used to format and parse the fields that have a textual representation.
+// Each table is indexed by TextStyle, then by the value of the field, starting at 0.
+type symbols struct {
+	months   [textStyleCount][12]string
+	weekdays [textStyleCount][7]string // Monday first, as the DayOfWeek field.
+	quarters [textStyleCount][4]string
+	eras     [textStyleCount][2]string // Before Christ first, as the Era field.
+	amPm     [textStyleCount][2]string
+}
+
+// text returns the texts of the values of the field in the style, or false if the field has no texts.
+func (s *symbols) text(field Field, style TextStyle) ([]string, bool) {
+	switch field {
+	case MonthOfYear:
+		return s.months[style][:], true
+	case DayOfWeek:
+		return s.weekdays[style][:], true
+	case QuarterOfYear:
+		return s.quarters[style][:], true
+	case Era:
+		return s.eras[style][:], true
+	case AmPmOfDay:
+		return s.amPm[style][:], true
+	default:
+		return nil, false
+	}
+}
+
+// hasText returns whether the field has texts.
+func hasText(field Field) bool {
+	switch field {
+	case Era, QuarterOfYear, MonthOfYear, DayOfWeek, AmPmOfDay:
+		return true
+	default:
+		return false
+	}
+}
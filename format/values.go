@@ -0,0 +1,258 @@
+package format
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+// fieldRanges are the inclusive bounds of the values of each field.
+var fieldRanges = [fieldCount][2]int64{ //nolint:gochecknoglobals // table of ranges, like a constant.
+	Era:             {0, 1},
+	Year:            {-999_999_999, 999_999_999},
+	YearOfEra:       {1, 1_000_000_000},
+	QuarterOfYear:   {1, 4},
+	MonthOfYear:     {1, 12},
+	DayOfMonth:      {1, 31},
+	DayOfYear:       {1, 366},
+	DayOfWeek:       {1, 7},
+	AmPmOfDay:       {0, 1},
+	HourOfDay:       {0, 23},
+	ClockHourOfDay:  {1, 24},
+	HourOfAmPm:      {0, 11},
+	ClockHourOfAmPm: {1, 12},
+	MinuteOfHour:    {0, 59},
+	SecondOfMinute:  {0, 59},
+	NanoOfSecond:    {0, 999_999_999},
+}
+
+// values are the fields being formatted, or the fields found while parsing.
+type values struct {
+	fields [fieldCount]int64
+	set    uint32
+
+	// offset is the offset from UTC in seconds, if hasOffset.
+	offset    int
+	hasOffset bool
+	loc       *time.Location
+}
+
+func (v *values) get(field Field) (int64, bool) {
+	return v.fields[field], v.has(field)
+}
+
+func (v *values) has(field Field) bool {
+	return v.set&(1<<field) != 0
+}
+
+// put sets the value of the field, returning false if the field was already set to a different value.
+func (v *values) put(field Field, value int64) bool {
+	if v.has(field) {
+		return v.fields[field] == value
+	}
+
+	v.fields[field] = value
+	v.set |= 1 << field
+
+	return true
+}
+
+// putDate sets the date fields of the LocalDate.
+func (v *values) putDate(ld localdate.LocalDate) {
+	year := int64(ld.Year())
+	era, yearOfEra := int64(1), year
+
+	if year <= 0 {
+		era, yearOfEra = 0, 1-year
+	}
+
+	v.put(Era, era)
+	v.put(Year, year)
+	v.put(YearOfEra, yearOfEra)
+	v.put(QuarterOfYear, int64(ld.Quarter()))
+	v.put(MonthOfYear, int64(ld.Month()))
+	v.put(DayOfMonth, int64(ld.Day()))
+	v.put(DayOfYear, int64(ld.DayOfYear()))
+	v.put(DayOfWeek, int64(ld.Weekday()+6)%7+1)
+}
+
+// putTime sets the time fields of the LocalTime.
+func (v *values) putTime(lt localtime.LocalTime) {
+	hour := int64(lt.Hour())
+
+	v.put(AmPmOfDay, hour/12)
+	v.put(HourOfDay, hour)
+	v.put(ClockHourOfDay, (hour+23)%24+1)
+	v.put(HourOfAmPm, hour%12)
+	v.put(ClockHourOfAmPm, (hour+11)%12+1)
+	v.put(MinuteOfHour, int64(lt.Min()))
+	v.put(SecondOfMinute, int64(lt.Sec()))
+	v.put(NanoOfSecond, int64(lt.Nanosecond()))
+}
+
+// putZonedTime sets the date and time fields, the offset and the location of the time.Time.
+func (v *values) putZonedTime(t time.Time) {
+	v.putDate(localdate.FromTime(t))
+	v.putTime(localtime.New(t.Hour(), t.Minute(), t.Second(), t.Nanosecond()))
+
+	_, v.offset = t.Zone()
+	v.hasOffset = true
+	v.loc = t.Location()
+}
+
+// checkRanges checks that the values of the fields found while parsing are in range.
+func (v *values) checkRanges() error {
+	for field := range fieldCount {
+		value, ok := v.get(field)
+		if ok && (value < fieldRanges[field][0] || value > fieldRanges[field][1]) {
+			return fmt.Errorf("%v %d out of range [%d, %d]", field, value, fieldRanges[field][0], fieldRanges[field][1])
+		}
+	}
+
+	return nil
+}
+
+// crossCheck checks that the fields found while parsing match the fields of the resolved date or time.
+func (v *values) crossCheck(resolved *values, what fmt.Stringer) error {
+	for field := range fieldCount {
+		value, ok := v.get(field)
+		if ok && resolved.has(field) && resolved.fields[field] != value {
+			return fmt.Errorf("%v %d does not match %v", field, value, what)
+		}
+	}
+
+	return nil
+}
+
+// date resolves the LocalDate from the fields found while parsing.
+func (v *values) date() (localdate.LocalDate, error) {
+	if err := v.checkRanges(); err != nil {
+		return localdate.LocalDate{}, err
+	}
+
+	year, err := v.year()
+	if err != nil {
+		return localdate.LocalDate{}, err
+	}
+
+	month, hasMonth := v.get(MonthOfYear)
+	day, hasDay := v.get(DayOfMonth)
+	dayOfYear, hasDayOfYear := v.get(DayOfYear)
+
+	var ld localdate.LocalDate
+
+	switch {
+	case hasMonth && hasDay:
+		ld, err = localdate.Of(int(year), time.Month(month), int(day))
+		if err != nil {
+			return localdate.LocalDate{}, err
+		}
+	case hasDayOfYear:
+		ld = localdate.New(int(year), time.January, 1)
+		if int(dayOfYear) > ld.LengthOfYear() {
+			return localdate.LocalDate{}, fmt.Errorf("%v %d out of range for year %d", DayOfYear, dayOfYear, year)
+		}
+
+		ld = ld.PlusDays(int(dayOfYear) - 1)
+	case hasMonth:
+		return localdate.LocalDate{}, fmt.Errorf("missing field %v", DayOfMonth)
+	default:
+		return localdate.LocalDate{}, fmt.Errorf("missing field %v", MonthOfYear)
+	}
+
+	var resolved values
+	resolved.putDate(ld)
+
+	if err = v.crossCheck(&resolved, ld); err != nil {
+		return localdate.LocalDate{}, err
+	}
+
+	return ld, nil
+}
+
+// hasTime returns whether any time field was found while parsing.
+func (v *values) hasTime() bool {
+	for field := AmPmOfDay; field < fieldCount; field++ {
+		if v.has(field) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// time resolves the LocalTime from the fields found while parsing, the minutes, seconds and nanoseconds being 0
+// if missing.
+func (v *values) time() (localtime.LocalTime, error) {
+	if err := v.checkRanges(); err != nil {
+		return localtime.LocalTime{}, err
+	}
+
+	hour, err := v.hour()
+	if err != nil {
+		return localtime.LocalTime{}, err
+	}
+
+	lt, err := localtime.Of(int(hour), int(v.fields[MinuteOfHour]), int(v.fields[SecondOfMinute]),
+		int(v.fields[NanoOfSecond]))
+	if err != nil {
+		return localtime.LocalTime{}, err
+	}
+
+	var resolved values
+	resolved.putTime(lt)
+
+	if err = v.crossCheck(&resolved, lt); err != nil {
+		return localtime.LocalTime{}, err
+	}
+
+	return lt, nil
+}
+
+// hour resolves the hour of the day, from HourOfDay, ClockHourOfDay, or AmPmOfDay with HourOfAmPm or
+// ClockHourOfAmPm.
+func (v *values) hour() (int64, error) {
+	if hour, ok := v.get(HourOfDay); ok {
+		return hour, nil
+	}
+
+	if hour, ok := v.get(ClockHourOfDay); ok {
+		return hour % 24, nil
+	}
+
+	hour, ok := v.get(HourOfAmPm)
+	if !ok {
+		hour, ok = v.get(ClockHourOfAmPm)
+	}
+
+	if !ok {
+		return 0, fmt.Errorf("missing field %v", HourOfDay)
+	}
+
+	amPm, ok := v.get(AmPmOfDay)
+	if !ok {
+		return 0, fmt.Errorf("missing field %v", AmPmOfDay)
+	}
+
+	return amPm*12 + hour%12, nil
+}
+
+// year resolves the proleptic year, from Year, or YearOfEra and Era, the current era by default.
+func (v *values) year() (int64, error) {
+	if year, ok := v.get(Year); ok {
+		return year, nil
+	}
+
+	yearOfEra, ok := v.get(YearOfEra)
+	if !ok {
+		return 0, fmt.Errorf("missing field %v", Year)
+	}
+
+	if era, ok := v.get(Era); ok && era == 0 {
+		return 1 - yearOfEra, nil
+	}
+
+	return yearOfEra, nil
+}
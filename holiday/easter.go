@@ -0,0 +1,37 @@
+package holiday
+
+import (
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+// Easter returns the date of the Western Easter Sunday in the year, computed with the Gregorian computus.
+func Easter(year int) localdate.LocalDate {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+
+	return localdate.New(year, time.Month(n/31), n%31+1)
+}
+
+// OrthodoxEaster returns the date of the Orthodox Easter Sunday in the year, computed with the Julian computus and
+// converted to the Gregorian calendar.
+func OrthodoxEaster(year int) localdate.LocalDate {
+	a, b, c := year%4, year%7, year%19
+	d := (19*c + 15) % 30
+	e := (2*a + 4*b - d + 34) % 7
+	n := d + e + 114
+
+	// The Julian calendar drifts one day from the Gregorian one every century not divisible by 400.
+	drift := year/100 - year/400 - 2
+
+	return localdate.Normalize(year, time.Month(n/31), n%31+1+drift)
+}
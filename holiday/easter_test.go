@@ -0,0 +1,67 @@
+package holiday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestEaster(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year     int
+		western  localdate.LocalDate
+		orthodox localdate.LocalDate
+	}{
+		"2000": {
+			year:     2000,
+			western:  localdate.New(2000, time.April, 23),
+			orthodox: localdate.New(2000, time.April, 30),
+		},
+		"2021": {
+			year:     2021,
+			western:  localdate.New(2021, time.April, 4),
+			orthodox: localdate.New(2021, time.May, 2),
+		},
+		"2024": {
+			year:     2024,
+			western:  localdate.New(2024, time.March, 31),
+			orthodox: localdate.New(2024, time.May, 5),
+		},
+		"2025, same day": {
+			year:     2025,
+			western:  localdate.New(2025, time.April, 20),
+			orthodox: localdate.New(2025, time.April, 20),
+		},
+		"1818, earliest possible": {
+			year:     1818,
+			western:  localdate.New(1818, time.March, 22),
+			orthodox: localdate.New(1818, time.April, 26),
+		},
+		"2038, latest possible": {
+			year:     2038,
+			western:  localdate.New(2038, time.April, 25),
+			orthodox: localdate.New(2038, time.April, 25),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Easter(test.year); got != test.western {
+				t.Errorf("Easter(%d) = %v, want %v", test.year, got, test.western)
+			}
+
+			if got := OrthodoxEaster(test.year); got != test.orthodox {
+				t.Errorf("OrthodoxEaster(%d) = %v, want %v", test.year, got, test.orthodox)
+			}
+
+			if got := Easter(test.year).Weekday(); got != time.Sunday {
+				t.Errorf("Easter(%d).Weekday() = %v, want Sunday", test.year, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Package holiday provides a rules engine to declare holiday calendars, in code or from JSON, and compute their dates
+// for any year.
+package holiday
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+
+	"github.com/manuelarte/gotimeplus/businessday"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ businessday.HolidayCalendar = Calendar{}
+
+type (
+	// Calendar computes the holidays of a set of rules, moving the ones falling on its weekend according to their
+	// Observance.
+	// It implements businessday.HolidayCalendar, so it can be used to build a businessday.Calendar.
+	// It is safe for concurrent use.
+	Calendar struct {
+		rules   []Rule
+		weekend businessday.Weekend
+		// dates caches the set of observed dates of each year already computed by IsHoliday, shared by the copies
+		// of the Calendar.
+		dates *sync.Map
+	}
+
+	// Holiday is a holiday happening on a specific date.
+	Holiday struct {
+		// Name of the holiday.
+		Name string
+		// Date on which the holiday is observed.
+		Date localdate.LocalDate
+		// Actual is the date of the holiday before applying its observance, equal to Date if it was not moved.
+		Actual localdate.LocalDate
+	}
+)
+
+// New Calendar from the given rules, with a Saturday and Sunday weekend. Use WithWeekend to change it.
+// Returns a *RuleError if any of the rules is invalid.
+func New(rules ...Rule) (Calendar, error) {
+	for _, r := range rules {
+		if err := r.Validate(); err != nil {
+			return Calendar{}, err
+		}
+	}
+
+	return Calendar{rules: slices.Clone(rules), weekend: businessday.SaturdaySunday, dates: &sync.Map{}}, nil
+}
+
+// Load a Calendar from JSON, an object with the list of rules, e.g.
+//
+//	{"rules": [
+//	  {"name": "Christmas Day", "kind": "fixed", "month": 12, "day": 25, "observance": "monday-if-weekend"},
+//	  {"name": "Good Friday", "kind": "easter", "offset": -2}
+//	]}
+//
+// Returns an error if the JSON can't be decoded or any of the rules is invalid.
+func Load(r io.Reader) (Calendar, error) {
+	var data struct {
+		Rules []Rule `json:"rules"`
+	}
+
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(&data); err != nil {
+		return Calendar{}, fmt.Errorf("holiday: decoding calendar: %w", err)
+	}
+
+	return New(data.Rules...)
+}
+
+// Dates returns the sorted dates of the holidays observed in the year, without duplicates.
+func (c Calendar) Dates(year int) []localdate.LocalDate {
+	holidays := c.Holidays(year)
+
+	dates := make([]localdate.LocalDate, 0, len(holidays))
+	for _, h := range holidays {
+		if len(dates) == 0 || dates[len(dates)-1] != h.Date {
+			dates = append(dates, h.Date)
+		}
+	}
+
+	return dates
+}
+
+// Holidays returns the holidays observed in the year, sorted by date and name.
+// Holidays falling on the weekend are moved according to the Observance of their rule, and if the observed day is
+// already a holiday, they are pushed to the next weekday that is not, e.g. Christmas Day on Saturday and Boxing Day
+// on Sunday are observed on Monday and Tuesday.
+// A holiday can be observed in a different year than its actual date, e.g. New Year's Day on Saturday observed on the
+// nearest weekday is December 31st of the year before.
+func (c Calendar) Holidays(year int) []Holiday {
+	type movedHoliday struct {
+		Holiday
+
+		observance Observance
+	}
+
+	var (
+		holidays []Holiday
+		moved    []movedHoliday
+	)
+
+	taken := make(map[localdate.LocalDate]struct{})
+	isTaken := func(ld localdate.LocalDate) bool {
+		_, ok := taken[ld]
+
+		return ok || c.weekend.Contains(ld.Weekday())
+	}
+
+	// Observed days can cross the year boundary, so the surrounding years are computed too.
+	for y := year - 1; y <= year+1; y++ {
+		for _, r := range c.rules {
+			ld, ok := r.Date(y)
+			if !ok {
+				continue
+			}
+
+			h := Holiday{Name: r.Name, Date: ld, Actual: ld}
+			if r.Observance == NotObserved || !c.weekend.Contains(ld.Weekday()) {
+				holidays = append(holidays, h)
+				taken[ld] = struct{}{}
+
+				continue
+			}
+
+			moved = append(moved, movedHoliday{Holiday: h, observance: r.Observance})
+		}
+	}
+
+	slices.SortStableFunc(moved, func(a, b movedHoliday) int {
+		return a.Actual.Compare(b.Actual)
+	})
+
+	for _, m := range moved {
+		h := m.Holiday
+
+		h.Date = m.observance.observe(h.Actual, c.weekend)
+		for isTaken(h.Date) {
+			h.Date = h.Date.PlusDays(1)
+		}
+
+		holidays = append(holidays, h)
+		taken[h.Date] = struct{}{}
+	}
+
+	holidays = slices.DeleteFunc(holidays, func(h Holiday) bool {
+		return h.Date.Year() != year
+	})
+
+	slices.SortFunc(holidays, func(a, b Holiday) int {
+		return cmp.Or(a.Date.Compare(b.Date), cmp.Compare(a.Name, b.Name))
+	})
+
+	return holidays
+}
+
+// IsHoliday reports whether a holiday is observed on the date.
+// The holidays of each year are computed once and cached.
+func (c Calendar) IsHoliday(ld localdate.LocalDate) bool {
+	if len(c.rules) == 0 {
+		return false
+	}
+
+	dates, ok := c.dates.Load(ld.Year())
+	if !ok {
+		dates, _ = c.dates.LoadOrStore(ld.Year(), c.dateSet(ld.Year()))
+	}
+
+	set, _ := dates.(map[localdate.LocalDate]struct{})
+	_, ok = set[ld]
+
+	return ok
+}
+
+// Rules returns a copy of the rules of the Calendar.
+func (c Calendar) Rules() []Rule {
+	return slices.Clone(c.rules)
+}
+
+// Weekend returns the weekend of the Calendar, whose holidays are moved according to their Observance.
+func (c Calendar) Weekend() businessday.Weekend {
+	return c.weekend
+}
+
+// WithWeekend returns a copy of the Calendar moving the holidays falling on the given weekend, instead of Saturday
+// and Sunday, e.g. businessday.FridaySaturday. It should be the weekend of the businessday.Calendar it is used with.
+// Returns businessday.ErrNoBusinessDays if the weekend contains every day of the week.
+func (c Calendar) WithWeekend(weekend businessday.Weekend) (Calendar, error) {
+	if _, err := businessday.New(weekend); err != nil {
+		return Calendar{}, err
+	}
+
+	return Calendar{rules: c.rules, weekend: weekend, dates: &sync.Map{}}, nil
+}
+
+// dateSet returns the set of dates of the holidays observed in the year.
+func (c Calendar) dateSet(year int) map[localdate.LocalDate]struct{} {
+	holidays := c.Holidays(year)
+
+	dates := make(map[localdate.LocalDate]struct{}, len(holidays))
+	for _, h := range holidays {
+		dates[h.Date] = struct{}{}
+	}
+
+	return dates
+}
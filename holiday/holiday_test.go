@@ -0,0 +1,295 @@
+package holiday
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/businessday"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestCalendarHolidays(t *testing.T) {
+	t.Parallel()
+
+	uk, err := New(
+		Fixed("New Year's Day", time.January, 1).Observed(MondayIfWeekend),
+		EasterOffset("Good Friday", -2),
+		EasterOffset("Easter Monday", 1),
+		Fixed("Christmas Day", time.December, 25).Observed(MondayIfWeekend),
+		Fixed("Boxing Day", time.December, 26).Observed(MondayIfWeekend),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	us, err := New(
+		Fixed("New Year's Day", time.January, 1).Observed(NearestWeekday),
+		Fixed("Juneteenth", time.June, 19).Observed(NearestWeekday).ValidBetween(2021, 0),
+		Fixed("Independence Day", time.July, 4).Observed(NearestWeekday),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		calendar Calendar
+		year     int
+		want     []Holiday
+	}{
+		"Christmas on Saturday and Boxing Day on Sunday": {
+			calendar: uk,
+			year:     2021,
+			want: []Holiday{
+				observed("New Year's Day", localdate.New(2021, time.January, 1), localdate.New(2021, time.January, 1)),
+				observed("Good Friday", localdate.New(2021, time.April, 2), localdate.New(2021, time.April, 2)),
+				observed("Easter Monday", localdate.New(2021, time.April, 5), localdate.New(2021, time.April, 5)),
+				observed("Christmas Day", localdate.New(2021, time.December, 27), localdate.New(2021, time.December, 25)),
+				observed("Boxing Day", localdate.New(2021, time.December, 28), localdate.New(2021, time.December, 26)),
+			},
+		},
+		"Christmas on Sunday and Boxing Day on Monday": {
+			calendar: uk,
+			year:     2022,
+			want: []Holiday{
+				observed("New Year's Day", localdate.New(2022, time.January, 3), localdate.New(2022, time.January, 1)),
+				observed("Good Friday", localdate.New(2022, time.April, 15), localdate.New(2022, time.April, 15)),
+				observed("Easter Monday", localdate.New(2022, time.April, 18), localdate.New(2022, time.April, 18)),
+				observed("Boxing Day", localdate.New(2022, time.December, 26), localdate.New(2022, time.December, 26)),
+				observed("Christmas Day", localdate.New(2022, time.December, 27), localdate.New(2022, time.December, 25)),
+			},
+		},
+		"Observed in the previous year": {
+			calendar: us,
+			year:     2021,
+			want: []Holiday{
+				observed("New Year's Day", localdate.New(2021, time.January, 1), localdate.New(2021, time.January, 1)),
+				observed("Juneteenth", localdate.New(2021, time.June, 18), localdate.New(2021, time.June, 19)),
+				observed("Independence Day", localdate.New(2021, time.July, 5), localdate.New(2021, time.July, 4)),
+				observed("New Year's Day", localdate.New(2021, time.December, 31), localdate.New(2022, time.January, 1)),
+			},
+		},
+		"Before a rule is valid": {
+			calendar: us,
+			year:     2020,
+			want: []Holiday{
+				observed("New Year's Day", localdate.New(2020, time.January, 1), localdate.New(2020, time.January, 1)),
+				observed("Independence Day", localdate.New(2020, time.July, 3), localdate.New(2020, time.July, 4)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.calendar.Holidays(test.year); !slices.Equal(got, test.want) {
+				t.Errorf("Holidays(%d) = %v, want %v", test.year, got, test.want)
+			}
+		})
+	}
+}
+
+func TestCalendarDates(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := New(
+		Fixed("Labour Day", time.May, 1),
+		Fixed("May Day", time.May, 1),
+		Fixed("New Year's Day", time.January, 1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []localdate.LocalDate{localdate.New(2024, time.January, 1), localdate.New(2024, time.May, 1)}
+	if got := calendar.Dates(2024); !slices.Equal(got, want) {
+		t.Errorf("Dates = %v, want %v", got, want)
+	}
+
+	if !calendar.IsHoliday(localdate.New(2024, time.May, 1)) || calendar.IsHoliday(localdate.New(2024, time.May, 2)) {
+		t.Error("IsHoliday does not match the dates of the calendar")
+	}
+
+	if len(calendar.Rules()) != 3 {
+		t.Errorf("Rules = %v, want 3 rules", calendar.Rules())
+	}
+}
+
+func TestCalendarIsHoliday(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := New(
+		Fixed("New Year's Day", time.January, 1).Observed(NearestWeekday),
+		Fixed("Christmas Day", time.December, 25).Observed(MondayIfWeekend),
+		Fixed("Boxing Day", time.December, 26).Observed(MondayIfWeekend),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		ld   localdate.LocalDate
+		want bool
+	}{
+		"Christmas on Saturday is observed on Monday": {ld: localdate.New(2021, time.December, 27), want: true},
+		"Boxing Day is pushed to Tuesday":             {ld: localdate.New(2021, time.December, 28), want: true},
+		"New Year's Day observed the year before":     {ld: localdate.New(2021, time.December, 31), want: true},
+		"Actual date of a moved holiday":              {ld: localdate.New(2022, time.January, 1), want: false},
+		"Regular day":                                 {ld: localdate.New(2021, time.December, 29), want: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// The second call is answered from the cache of the year.
+			for range 2 {
+				if got := calendar.IsHoliday(test.ld); got != test.want {
+					t.Errorf("IsHoliday(%v) = %t, want %t", test.ld, got, test.want)
+				}
+			}
+		})
+	}
+
+	if (Calendar{}).IsHoliday(localdate.New(2021, time.December, 25)) {
+		t.Error("IsHoliday of the zero Calendar = true, want false")
+	}
+}
+
+func TestCalendarWithWeekend(t *testing.T) {
+	t.Parallel()
+
+	saturdaySunday, err := New(
+		Fixed("Moved", time.March, 1).Observed(MondayIfWeekend),
+		Fixed("Nearest", time.March, 8).Observed(NearestWeekday),
+		Fixed("Nearest Saturday", time.March, 16).Observed(NearestWeekday),
+		Fixed("Not observed", time.March, 22),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// In 2024, March 1st and 8th are Fridays, March 16th is a Saturday and March 22nd a Friday.
+	calendar, err := saturdaySunday.WithWeekend(businessday.FridaySaturday)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if calendar.Weekend() != businessday.FridaySaturday || saturdaySunday.Weekend() != businessday.SaturdaySunday {
+		t.Errorf("Weekend = %07b, want %07b", calendar.Weekend(), businessday.FridaySaturday)
+	}
+
+	want := []Holiday{
+		observed("Moved", localdate.New(2024, time.March, 3), localdate.New(2024, time.March, 1)),
+		observed("Nearest", localdate.New(2024, time.March, 7), localdate.New(2024, time.March, 8)),
+		observed("Nearest Saturday", localdate.New(2024, time.March, 17), localdate.New(2024, time.March, 16)),
+		observed("Not observed", localdate.New(2024, time.March, 22), localdate.New(2024, time.March, 22)),
+	}
+	if got := calendar.Holidays(2024); !slices.Equal(got, want) {
+		t.Errorf("Holidays = %v, want %v", got, want)
+	}
+
+	// A Sunday holiday is a working day with a Friday-Saturday weekend, so it's not moved.
+	sunday, err := New(Fixed("Sunday", time.March, 3).Observed(MondayIfWeekend))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sunday, err = sunday.WithWeekend(businessday.FridaySaturday)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !sunday.IsHoliday(localdate.New(2024, time.March, 3)) || sunday.IsHoliday(localdate.New(2024, time.March, 4)) {
+		t.Error("IsHoliday moved a holiday falling on a working Sunday")
+	}
+
+	if _, err = sunday.WithWeekend(businessday.NewWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday,
+		time.Thursday, time.Friday, time.Saturday)); !errors.Is(err, businessday.ErrNoBusinessDays) {
+		t.Errorf("WithWeekend error = %v, want %v", err, businessday.ErrNoBusinessDays)
+	}
+}
+
+func TestNewInvalidRule(t *testing.T) {
+	t.Parallel()
+
+	var ruleErr *RuleError
+	if _, err := New(Fixed("Nope", time.April, 31)); !errors.As(err, &ruleErr) {
+		t.Errorf("New error = %v, want *RuleError", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	calendar, err := Load(strings.NewReader(`{"rules": [
+		{"name": "Christmas Day", "kind": "fixed", "month": 12, "day": 25, "observance": "monday-if-weekend"},
+		{"name": "Good Friday", "kind": "easter", "offset": -2},
+		{"name": "Orthodox Easter", "kind": "orthodox-easter"},
+		{"name": "Spring Bank Holiday", "kind": "weekday-in-month", "month": 5, "weekday": 1, "n": -1, "fromYear": 1971}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []localdate.LocalDate{
+		localdate.New(2024, time.March, 29),
+		localdate.New(2024, time.May, 5),
+		localdate.New(2024, time.May, 27),
+		localdate.New(2024, time.December, 25),
+	}
+	if got := calendar.Dates(2024); !slices.Equal(got, want) {
+		t.Errorf("Dates = %v, want %v", got, want)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data string
+	}{
+		"Invalid JSON":  {data: `{"rules": [`},
+		"Unknown field": {data: `{"rules": [{"name": "Nope", "kind": "fixed", "month": 1, "day": 1, "year": 2024}]}`},
+		"Invalid rule":  {data: `{"rules": [{"name": "Nope", "kind": "fixed", "month": 2, "day": 30}]}`},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := Load(strings.NewReader(test.data)); err == nil {
+				t.Error("Load error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBusinessDayCalendar(t *testing.T) {
+	t.Parallel()
+
+	holidays, err := New(
+		Fixed("Christmas Day", time.December, 25).Observed(MondayIfWeekend),
+		Fixed("Boxing Day", time.December, 26).Observed(MondayIfWeekend),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calendar, err := businessday.New(businessday.SaturdaySunday, holidays)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := calendar.NextBusinessDay(localdate.New(2021, time.December, 24))
+	if want := localdate.New(2021, time.December, 29); !ok || got != want {
+		t.Errorf("NextBusinessDay = %v, %t, want %v, true", got, ok, want)
+	}
+}
+
+func observed(name string, date, actual localdate.LocalDate) Holiday {
+	return Holiday{Name: name, Date: date, Actual: actual}
+}
@@ -0,0 +1,209 @@
+package holiday
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/businessday"
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/monthday"
+)
+
+const (
+	// KindFixed is a holiday on the same Month and Day every year, e.g. Christmas Day.
+	KindFixed Kind = "fixed"
+	// KindWeekdayInMonth is a holiday on the N-th Weekday of the Month, e.g. the last Monday of May.
+	KindWeekdayInMonth Kind = "weekday-in-month"
+	// KindEaster is a holiday Offset days from the Western Easter Sunday, e.g. Good Friday.
+	KindEaster Kind = "easter"
+	// KindOrthodoxEaster is a holiday Offset days from the Orthodox Easter Sunday.
+	KindOrthodoxEaster Kind = "orthodox-easter"
+)
+
+const (
+	// NotObserved keeps the holiday on its date, even on weekends.
+	NotObserved Observance = ""
+	// MondayIfWeekend moves a holiday on Saturday or Sunday to the following Monday, or in general a holiday on the
+	// weekend of the Calendar to the first day after it.
+	MondayIfWeekend Observance = "monday-if-weekend"
+	// NearestWeekday moves a holiday on Saturday to the Friday before, and on Sunday to the Monday after, or in
+	// general a holiday on the weekend of the Calendar to the nearest day that is not, the day after on a tie.
+	NearestWeekday Observance = "nearest-weekday"
+)
+
+type (
+	// Kind of Rule, which decides how the date of the holiday is computed.
+	Kind string
+
+	// Observance decides on which day a holiday falling on the weekend of the Calendar is observed.
+	Observance string
+
+	// Rule declares a holiday that happens every year.
+	// Only the fields used by its Kind are taken into account.
+	Rule struct {
+		// Name of the holiday, e.g. "Christmas Day".
+		Name string `json:"name"`
+		// Kind of the rule.
+		Kind Kind `json:"kind"`
+		// Month of KindFixed and KindWeekdayInMonth rules, from 1 to 12.
+		Month time.Month `json:"month,omitempty"`
+		// Day of the month of KindFixed rules. February 29th is only a holiday in leap years.
+		Day int `json:"day,omitempty"`
+		// Weekday of KindWeekdayInMonth rules, from 0 (Sunday) to 6 (Saturday).
+		Weekday time.Weekday `json:"weekday,omitempty"`
+		// N is the occurrence of the Weekday in the Month of KindWeekdayInMonth rules, from 1 to 5, or from -1 to -5
+		// counting from the end of the month. Years without a 5th occurrence have no holiday.
+		N int `json:"n,omitempty"`
+		// Offset is the number of days from Easter Sunday of KindEaster and KindOrthodoxEaster rules.
+		Offset int `json:"offset,omitempty"`
+		// Observance moves the holiday when it falls on the weekend of the Calendar, Saturday and Sunday by default.
+		Observance Observance `json:"observance,omitempty"`
+		// FromYear is the first year of the holiday, 0 if it has no start.
+		FromYear int `json:"fromYear,omitempty"`
+		// ToYear is the last year of the holiday, 0 if it has no end.
+		ToYear int `json:"toYear,omitempty"`
+	}
+
+	// RuleError describes an invalid Rule.
+	RuleError struct {
+		// Rule is the name of the invalid rule.
+		Rule string
+		// Reason explains why the rule is invalid.
+		Reason string
+	}
+)
+
+// Fixed returns a Rule for a holiday on the same month and day every year, e.g. Christmas Day.
+func Fixed(name string, month time.Month, day int) Rule {
+	return Rule{Name: name, Kind: KindFixed, Month: month, Day: day}
+}
+
+// WeekdayInMonth returns a Rule for a holiday on the n-th weekday of the month, e.g. Thanksgiving Day in the United
+// States is WeekdayInMonth("Thanksgiving Day", 4, time.Thursday, time.November).
+// A negative n counts from the end of the month, -1 being the last one.
+func WeekdayInMonth(name string, n int, weekday time.Weekday, month time.Month) Rule {
+	return Rule{Name: name, Kind: KindWeekdayInMonth, Month: month, Weekday: weekday, N: n}
+}
+
+// LastWeekdayInMonth returns a Rule for a holiday on the last weekday of the month, e.g. the last Monday of May.
+func LastWeekdayInMonth(name string, weekday time.Weekday, month time.Month) Rule {
+	return WeekdayInMonth(name, -1, weekday, month)
+}
+
+// EasterOffset returns a Rule for a holiday offset days from the Western Easter Sunday, e.g. -2 for Good Friday.
+func EasterOffset(name string, offset int) Rule {
+	return Rule{Name: name, Kind: KindEaster, Offset: offset}
+}
+
+// OrthodoxEasterOffset returns a Rule for a holiday offset days from the Orthodox Easter Sunday.
+func OrthodoxEasterOffset(name string, offset int) Rule {
+	return Rule{Name: name, Kind: KindOrthodoxEaster, Offset: offset}
+}
+
+// Date returns the date of the holiday in the year, before applying its Observance.
+// Returns false if the holiday does not happen in the year.
+func (r Rule) Date(year int) (localdate.LocalDate, bool) {
+	if (r.FromYear != 0 && year < r.FromYear) || (r.ToYear != 0 && year > r.ToYear) {
+		return localdate.LocalDate{}, false
+	}
+
+	switch r.Kind {
+	case KindFixed:
+		return monthday.New(r.Month, r.Day).AtYear(year, localdate.LeapDaySkip)
+	case KindWeekdayInMonth:
+		ld := localdate.New(year, r.Month, 1).With(localdate.DayOfWeekInMonth(r.N, r.Weekday))
+		if ld.Month() != r.Month {
+			return localdate.LocalDate{}, false
+		}
+
+		return ld, true
+	case KindEaster:
+		return Easter(year).PlusDays(r.Offset), true
+	case KindOrthodoxEaster:
+		return OrthodoxEaster(year).PlusDays(r.Offset), true
+	default:
+		return localdate.LocalDate{}, false
+	}
+}
+
+// Observed returns a copy of the Rule with the given Observance.
+func (r Rule) Observed(observance Observance) Rule {
+	r.Observance = observance
+
+	return r
+}
+
+// Validate returns a *RuleError if the fields used by the Kind of the Rule are out of range.
+func (r Rule) Validate() error {
+	switch r.Kind {
+	case KindFixed:
+		if _, err := monthday.Of(r.Month, r.Day); err != nil {
+			return &RuleError{Rule: r.Name, Reason: err.Error()}
+		}
+	case KindWeekdayInMonth:
+		if r.Month < time.January || r.Month > time.December {
+			return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("month %d out of range [1, 12]", r.Month)}
+		}
+
+		if r.Weekday < time.Sunday || r.Weekday > time.Saturday {
+			return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("weekday %d out of range [0, 6]", r.Weekday)}
+		}
+
+		if r.N == 0 || r.N < -5 || r.N > 5 {
+			return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("n %d out of range [-5, -1] or [1, 5]", r.N)}
+		}
+	case KindEaster, KindOrthodoxEaster:
+	default:
+		return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("unknown kind %q", r.Kind)}
+	}
+
+	switch r.Observance {
+	case NotObserved, MondayIfWeekend, NearestWeekday:
+	default:
+		return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("unknown observance %q", r.Observance)}
+	}
+
+	if r.FromYear != 0 && r.ToYear != 0 && r.ToYear < r.FromYear {
+		return &RuleError{Rule: r.Name, Reason: fmt.Sprintf("to year %d before from year %d", r.ToYear, r.FromYear)}
+	}
+
+	return nil
+}
+
+// ValidBetween returns a copy of the Rule that only happens from fromYear to toYear, both inclusive.
+// Use 0 for a rule without a start or an end.
+func (r Rule) ValidBetween(fromYear, toYear int) Rule {
+	r.FromYear, r.ToYear = fromYear, toYear
+
+	return r
+}
+
+func (e *RuleError) Error() string {
+	return fmt.Sprintf("holiday: invalid rule %q: %s", e.Rule, e.Reason)
+}
+
+// observe returns the day the holiday on ld is observed, before resolving collisions with other holidays, when the
+// days of the weekend are not working days.
+func (o Observance) observe(ld localdate.LocalDate, weekend businessday.Weekend) localdate.LocalDate {
+	if o == NotObserved || !weekend.Contains(ld.Weekday()) {
+		return ld
+	}
+
+	after := 1
+	for weekend.Contains(ld.PlusDays(after).Weekday()) {
+		after++
+	}
+
+	if o == NearestWeekday {
+		before := 1
+		for weekend.Contains(ld.MinusDays(before).Weekday()) {
+			before++
+		}
+
+		if before < after {
+			return ld.MinusDays(before)
+		}
+	}
+
+	return ld.PlusDays(after)
+}
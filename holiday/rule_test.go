@@ -0,0 +1,125 @@
+package holiday
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestRuleDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rule   Rule
+		year   int
+		want   localdate.LocalDate
+		wantOk bool
+	}{
+		"Fixed": {
+			rule:   Fixed("Christmas Day", time.December, 25),
+			year:   2024,
+			want:   localdate.New(2024, time.December, 25),
+			wantOk: true,
+		},
+		"Fixed on leap day, non leap year": {
+			rule: Fixed("Leap Day", time.February, 29),
+			year: 2023,
+		},
+		"Nth weekday": {
+			rule:   WeekdayInMonth("Thanksgiving Day", 4, time.Thursday, time.November),
+			year:   2024,
+			want:   localdate.New(2024, time.November, 28),
+			wantOk: true,
+		},
+		"Last weekday": {
+			rule:   LastWeekdayInMonth("Memorial Day", time.Monday, time.May),
+			year:   2024,
+			want:   localdate.New(2024, time.May, 27),
+			wantOk: true,
+		},
+		"5th weekday that exists": {
+			rule:   WeekdayInMonth("Fifth Friday", 5, time.Friday, time.March),
+			year:   2024,
+			want:   localdate.New(2024, time.March, 29),
+			wantOk: true,
+		},
+		"5th weekday that does not exist": {
+			rule: WeekdayInMonth("Fifth Friday", 5, time.Friday, time.February),
+			year: 2024,
+		},
+		"Easter offset": {
+			rule:   EasterOffset("Good Friday", -2),
+			year:   2024,
+			want:   localdate.New(2024, time.March, 29),
+			wantOk: true,
+		},
+		"Orthodox Easter offset": {
+			rule:   OrthodoxEasterOffset("Orthodox Easter Monday", 1),
+			year:   2024,
+			want:   localdate.New(2024, time.May, 6),
+			wantOk: true,
+		},
+		"Before the first year": {
+			rule: Fixed("Juneteenth", time.June, 19).ValidBetween(2021, 0),
+			year: 2020,
+		},
+		"On the first year": {
+			rule:   Fixed("Juneteenth", time.June, 19).ValidBetween(2021, 0),
+			year:   2021,
+			want:   localdate.New(2021, time.June, 19),
+			wantOk: true,
+		},
+		"After the last year": {
+			rule: Fixed("Old holiday", time.May, 1).ValidBetween(0, 1999),
+			year: 2000,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := test.rule.Date(test.year)
+			if ok != test.wantOk || got != test.want {
+				t.Errorf("Date(%d) = %v, %v, want %v, %v", test.year, got, ok, test.want, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		rule    Rule
+		wantErr bool
+	}{
+		"Valid fixed":          {rule: Fixed("Leap Day", time.February, 29)},
+		"Valid nth weekday":    {rule: WeekdayInMonth("Labour Day", -5, time.Monday, time.May)},
+		"Valid easter":         {rule: EasterOffset("Easter Monday", 1).Observed(MondayIfWeekend)},
+		"Fixed, invalid day":   {rule: Fixed("Nope", time.February, 30), wantErr: true},
+		"Fixed, invalid month": {rule: Fixed("Nope", 13, 1), wantErr: true},
+		"Weekday, invalid n":   {rule: WeekdayInMonth("Nope", 0, time.Monday, time.May), wantErr: true},
+		"Weekday, n too big":   {rule: WeekdayInMonth("Nope", 6, time.Monday, time.May), wantErr: true},
+		"Weekday, invalid day": {rule: WeekdayInMonth("Nope", 1, 7, time.May), wantErr: true},
+		"Weekday, no month":    {rule: WeekdayInMonth("Nope", 1, time.Monday, 0), wantErr: true},
+		"Unknown kind":         {rule: Rule{Name: "Nope", Kind: "lunar"}, wantErr: true},
+		"Unknown observance":   {rule: EasterOffset("Nope", 0).Observed("friday"), wantErr: true},
+		"Years in reverse":     {rule: EasterOffset("Nope", 0).ValidBetween(2020, 2019), wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.rule.Validate()
+
+			var ruleErr *RuleError
+			if errors.As(err, &ruleErr) != test.wantErr {
+				t.Errorf("Validate = %v, want error %v", err, test.wantErr)
+			}
+		})
+	}
+}
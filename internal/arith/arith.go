@@ -0,0 +1,22 @@
+// Package arith provides the integer arithmetic shared by the calendar packages.
+package arith
+
+// Integer is the set of integer types supported by FloorDiv and FloorMod.
+type Integer interface {
+	int | int64
+}
+
+// FloorDiv returns the quotient of a and b rounded towards negative infinity.
+func FloorDiv[T Integer](a, b T) T {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+
+	return q
+}
+
+// FloorMod returns the remainder of the division of a and b rounded towards negative infinity, with the sign of b.
+func FloorMod[T Integer](a, b T) T {
+	return a - FloorDiv(a, b)*b
+}
@@ -0,0 +1,47 @@
+package arith
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloorDivMod(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b    int64
+		wantDiv int64
+		wantMod int64
+	}{
+		"Positive":              {a: 7, b: 2, wantDiv: 3, wantMod: 1},
+		"Exact":                 {a: 8, b: 2, wantDiv: 4, wantMod: 0},
+		"Negative dividend":     {a: -7, b: 2, wantDiv: -4, wantMod: 1},
+		"Negative exact":        {a: -8, b: 2, wantDiv: -4, wantMod: 0},
+		"Negative divisor":      {a: 7, b: -2, wantDiv: -4, wantMod: -1},
+		"Both negative":         {a: -7, b: -2, wantDiv: 3, wantMod: -1},
+		"Minimum int64":         {a: math.MinInt64, b: 86_400, wantDiv: -106_751_991_167_301, wantMod: 30_592},
+		"Maximum int64":         {a: math.MaxInt64, b: 86_400, wantDiv: 106_751_991_167_300, wantMod: 55_807},
+		"Smaller than divisor":  {a: -1, b: 7, wantDiv: -1, wantMod: 6},
+		"Zero dividend":         {a: 0, b: 7, wantDiv: 0, wantMod: 0},
+		"Divisor of one":        {a: -5, b: 1, wantDiv: -5, wantMod: 0},
+		"Large negative offset": {a: -86_401, b: 86_400, wantDiv: -2, wantMod: 86_399},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := FloorDiv(test.a, test.b); got != test.wantDiv {
+				t.Errorf("FloorDiv(%d, %d) = %d, want %d", test.a, test.b, got, test.wantDiv)
+			}
+
+			if got := FloorMod(test.a, test.b); got != test.wantMod {
+				t.Errorf("FloorMod(%d, %d) = %d, want %d", test.a, test.b, got, test.wantMod)
+			}
+
+			if got := FloorDiv(int(test.a), int(test.b)); int64(got) != test.wantDiv {
+				t.Errorf("FloorDiv[int](%d, %d) = %d, want %d", test.a, test.b, got, test.wantDiv)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Package codec provides the helpers shared by the packages formatting and parsing their values as ISO-8601 text,
+// JSON and SQL values.
+package codec
+
+import (
+	"math"
+	"strconv"
+)
+
+// AppendInt appends the non-negative integer i to b, left padded with zeros to the given width.
+func AppendInt(b []byte, i, width int) []byte {
+	for w := len(strconv.Itoa(i)); w < width; w++ {
+		b = append(b, '0')
+	}
+
+	return strconv.AppendInt(b, int64(i), 10)
+}
+
+// AppendYear appends the ISO-8601 year to b, with at least 4 digits.
+// Years outside [0, 9999] are prefixed with their sign, e.g. +12024 or -0001.
+func AppendYear(b []byte, year int) []byte {
+	// The absolute value is computed as an uint64, as -math.MinInt overflows an int.
+	abs := uint64(year)
+	switch {
+	case year > 9999:
+		b = append(b, '+')
+	case year < 0:
+		b = append(b, '-')
+		abs = -abs
+	}
+
+	for range len("YYYY") - len(strconv.FormatUint(abs, 10)) {
+		b = append(b, '0')
+	}
+
+	return strconv.AppendUint(b, abs, 10)
+}
+
+// Atoi parses a non-empty string made only of ASCII digits.
+// Returns false if the value overflows an int.
+func Atoi(s string) (int, bool) {
+	n, ok := atou(s)
+	if !ok || n > math.MaxInt {
+		return 0, false
+	}
+
+	return int(n), true
+}
+
+// ParseYear parses an ISO-8601 year, either 4 digits, e.g. 2024, or an expanded year of at least 4 digits prefixed by
+// its sign, e.g. +12024 or -0001.
+// Every year of an int is accepted, so any year appended by AppendYear can be parsed back.
+func ParseYear(s string) (int, bool) {
+	if len(s) == len("YYYY") {
+		return Atoi(s)
+	}
+
+	if len(s) < len("+YYYY") || (s[0] != '+' && s[0] != '-') {
+		return 0, false
+	}
+
+	abs, ok := atou(s[1:])
+	if !ok {
+		return 0, false
+	}
+
+	if s[0] == '+' {
+		if abs > math.MaxInt {
+			return 0, false
+		}
+
+		return int(abs), true
+	}
+
+	if abs > math.MaxInt+1 {
+		return 0, false
+	}
+
+	return int(-abs), true
+}
+
+// atou parses a non-empty string made only of ASCII digits into an uint64.
+// Returns false if the value overflows an uint64.
+func atou(s string) (uint64, bool) {
+	if s == "" {
+		return 0, false
+	}
+
+	var n uint64
+	for _, c := range []byte(s) {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+
+		d := uint64(c - '0')
+		if n > (math.MaxUint64-d)/10 {
+			return 0, false
+		}
+
+		n = n*10 + d
+	}
+
+	return n, true
+}
@@ -0,0 +1,120 @@
+package codec
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAppendInt(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		i, width int
+		want     string
+	}{
+		"Padded":          {i: 7, width: 2, want: "07"},
+		"Exact width":     {i: 12, width: 2, want: "12"},
+		"Wider than pad":  {i: 12345, width: 4, want: "12345"},
+		"Zero":            {i: 0, width: 3, want: "000"},
+		"Width of one":    {i: 9, width: 1, want: "9"},
+		"Nanoseconds pad": {i: 5, width: 9, want: "000000005"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := string(AppendInt([]byte("x"), test.i, test.width)); got != "x"+test.want {
+				t.Errorf("AppendInt(%d, %d) = %q, want %q", test.i, test.width, got, "x"+test.want)
+			}
+		})
+	}
+}
+
+func TestAppendYear(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year int
+		want string
+	}{
+		"Four digits":   {year: 2024, want: "2024"},
+		"Padded":        {year: 7, want: "0007"},
+		"Zero":          {year: 0, want: "0000"},
+		"Last unsigned": {year: 9999, want: "9999"},
+		"Expanded":      {year: 12024, want: "+12024"},
+		"Negative":      {year: -1, want: "-0001"},
+		"More than 9":   {year: 300_000_000_000, want: "+300000000000"},
+		"Maximum int":   {year: math.MaxInt, want: "+9223372036854775807"},
+		"Minimum int":   {year: math.MinInt, want: "-9223372036854775808"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := string(AppendYear(nil, test.year)); got != test.want {
+				t.Errorf("AppendYear(%d) = %q, want %q", test.year, got, test.want)
+			}
+
+			if got, ok := ParseYear(test.want); !ok || got != test.year {
+				t.Errorf("ParseYear(%q) = %d, %t, want %d, true", test.want, got, ok, test.year)
+			}
+		})
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		s      string
+		want   int
+		wantOK bool
+	}{
+		"Digits":         {s: "0042", want: 42, wantOK: true},
+		"Empty":          {s: ""},
+		"Sign":           {s: "+1"},
+		"Letter":         {s: "1a"},
+		"Overflows int":  {s: "9223372036854775808"},
+		"Overflows uint": {s: "99999999999999999999"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, ok := Atoi(test.s); got != test.want || ok != test.wantOK {
+				t.Errorf("Atoi(%q) = %d, %t, want %d, %t", test.s, got, ok, test.want, test.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseYearError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"Empty":                 "",
+		"Three digits":          "202",
+		"Unsigned five digits":  "12024",
+		"Signed three digits":   "+202",
+		"Sign without digits":   "+",
+		"Letters":               "20a4",
+		"Signed letters":        "-20a4",
+		"Unknown sign":          "*2024",
+		"Unsigned with letters": "abcd",
+		"Overflows int":         "+9223372036854775808",
+		"Underflows int":        "-9223372036854775809",
+	}
+
+	for name, s := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, ok := ParseYear(s); ok {
+				t.Errorf("ParseYear(%q) = %d, true, want false", s, got)
+			}
+		})
+	}
+}
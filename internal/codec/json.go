@@ -0,0 +1,41 @@
+package codec
+
+import (
+	"fmt"
+)
+
+// MarshalJSON returns the text appended by appendText as a JSON string, allocating room for size bytes of text.
+func MarshalJSON(size int, appendText func(b []byte) []byte) []byte {
+	b := make([]byte, 0, size+len(`""`))
+	b = append(b, '"')
+	b = appendText(b)
+
+	return append(b, '"')
+}
+
+// UnmarshalJSON passes the text of the JSON string data to unmarshalText. A JSON null is ignored.
+// name is the package and type being unmarshaled, e.g. "localdate: LocalDate", used in the error returned if data is
+// not a JSON string.
+func UnmarshalJSON(data []byte, name string, unmarshalText func(text []byte) error) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return fmt.Errorf("%s.UnmarshalJSON: input is not a JSON string", name)
+	}
+
+	return unmarshalText(data[1 : len(data)-1])
+}
+
+// UnmarshalText parses the text with parse and stores the result in v, which is left unchanged on error.
+func UnmarshalText[T any](v *T, text []byte, parse func(s string) (T, error)) error {
+	parsed, err := parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*v = parsed
+
+	return nil
+}
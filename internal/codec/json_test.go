@@ -0,0 +1,69 @@
+package codec
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	got := MarshalJSON(len("42"), func(b []byte) []byte {
+		return strconv.AppendInt(b, 42, 10)
+	})
+	if string(got) != `"42"` {
+		t.Errorf("MarshalJSON = %s, want %q", got, `"42"`)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data     string
+		wantText string
+		wantErr  bool
+	}{
+		"String":     {data: `"42"`, wantText: "42"},
+		"Null":       {data: "null"},
+		"Number":     {data: "42", wantErr: true},
+		"Unfinished": {data: `"42`, wantErr: true},
+		"Quote":      {data: `"`, wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var text string
+
+			err := UnmarshalJSON([]byte(test.data), "codec: Test", func(b []byte) error {
+				text = string(b)
+
+				return nil
+			})
+			if (err != nil) != test.wantErr {
+				t.Fatalf("UnmarshalJSON error = %v, want error %t", err, test.wantErr)
+			}
+
+			if text != test.wantText {
+				t.Errorf("UnmarshalJSON text = %q, want %q", text, test.wantText)
+			}
+		})
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	v := 1
+	if err := UnmarshalText(&v, []byte("42"), strconv.Atoi); err != nil || v != 42 {
+		t.Errorf("UnmarshalText = %d, %v, want 42, nil", v, err)
+	}
+
+	var numErr *strconv.NumError
+	if err := UnmarshalText(&v, []byte("x"), strconv.Atoi); !errors.As(err, &numErr) || v != 42 {
+		t.Errorf("UnmarshalText = %d, %v, want 42 unchanged and a *strconv.NumError", v, err)
+	}
+}
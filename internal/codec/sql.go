@@ -0,0 +1,27 @@
+package codec
+
+import (
+	"fmt"
+	"time"
+)
+
+// Scan converts the src of a sql.Scanner, calling fromTime for time.Time values, and fromText for []byte or string
+// values.
+// NULL and any other type are rejected with an error naming the package pkg and the type typ, e.g. "localdate" and
+// "LocalDate", suggesting its Null type instead for NULL.
+func Scan(src any, pkg, typ string, fromTime func(t time.Time), fromText func(s string) error) error {
+	switch v := src.(type) {
+	case time.Time:
+		fromTime(v)
+
+		return nil
+	case []byte:
+		return fromText(string(v))
+	case string:
+		return fromText(v)
+	case nil:
+		return fmt.Errorf("%s: cannot scan NULL into %s, use Null%s instead", pkg, typ, typ)
+	default:
+		return fmt.Errorf("%s: cannot scan %T into %s", pkg, src, typ)
+	}
+}
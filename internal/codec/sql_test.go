@@ -0,0 +1,55 @@
+package codec
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src     any
+		want    string
+		wantErr string
+	}{
+		"Time":   {src: time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC), want: "time"},
+		"Bytes":  {src: []byte("2024-02-29"), want: "2024-02-29"},
+		"String": {src: "2024-02-29", want: "2024-02-29"},
+		"Null": {
+			src:     nil,
+			wantErr: "localdate: cannot scan NULL into LocalDate, use NullLocalDate instead",
+		},
+		"Unsupported type": {
+			src:     42,
+			wantErr: "localdate: cannot scan int into LocalDate",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+
+			err := Scan(test.src, "localdate", "LocalDate", func(time.Time) {
+				got = "time"
+			}, func(s string) error {
+				got = s
+
+				return nil
+			})
+			if test.wantErr != "" {
+				if err == nil || err.Error() != test.wantErr {
+					t.Errorf("Scan error = %v, want %q", err, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil || got != test.want {
+				t.Errorf("Scan = %q, %v, want %q, nil", got, err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package localdate
+
+import "time"
+
+// Adjuster adjusts a LocalDate, e.g. to the last day of its month, or to the next Friday.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/temporal/TemporalAdjusters.html.
+type Adjuster func(LocalDate) LocalDate
+
+// Chain returns an Adjuster applying the given adjusters in order,
+// e.g. Chain(FirstDayOfNextMonth(), NextOrSame(time.Monday)) adjusts to the first Monday of next month.
+func Chain(adjusters ...Adjuster) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		for _, adjuster := range adjusters {
+			ld = adjuster(ld)
+		}
+
+		return ld
+	}
+}
+
+// DayOfWeekInMonth returns an Adjuster to the n-th given day of week in the month, e.g. the 2nd Tuesday.
+// A negative n counts from the end of the month, -1 being the last one.
+// As in java.time, n = 5 may adjust to the next month, and n = 0 adjusts to the last one in the previous month.
+func DayOfWeekInMonth(n int, dayOfWeek time.Weekday) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		if n >= 0 {
+			first := New(ld.Year(), ld.Month(), 1)
+			diff := (int(dayOfWeek) - int(first.Weekday()) + 7) % 7
+
+			return first.PlusDays(diff + (n-1)*7)
+		}
+
+		last := New(ld.Year(), ld.Month(), ld.LengthOfMonth())
+		diff := (int(last.Weekday()) - int(dayOfWeek) + 7) % 7
+
+		return last.MinusDays(diff + (-n-1)*7)
+	}
+}
+
+// FirstDayOfMonth returns an Adjuster to the first day of the month.
+func FirstDayOfMonth() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year(), ld.Month(), 1)
+	}
+}
+
+// FirstDayOfNextMonth returns an Adjuster to the first day of the next month.
+func FirstDayOfNextMonth() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year(), ld.Month(), 1).PlusMonths(1)
+	}
+}
+
+// FirstDayOfNextYear returns an Adjuster to the first day of the next year.
+func FirstDayOfNextYear() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year()+1, time.January, 1)
+	}
+}
+
+// FirstDayOfYear returns an Adjuster to the first day of the year.
+func FirstDayOfYear() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year(), time.January, 1)
+	}
+}
+
+// FirstInMonth returns an Adjuster to the first given day of week in the month.
+func FirstInMonth(dayOfWeek time.Weekday) Adjuster {
+	return DayOfWeekInMonth(1, dayOfWeek)
+}
+
+// LastDayOfMonth returns an Adjuster to the last day of the month.
+func LastDayOfMonth() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year(), ld.Month(), ld.LengthOfMonth())
+	}
+}
+
+// LastDayOfYear returns an Adjuster to the last day of the year.
+func LastDayOfYear() Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return New(ld.Year(), time.December, 31)
+	}
+}
+
+// LastInMonth returns an Adjuster to the last given day of week in the month, e.g. the last Friday.
+func LastInMonth(dayOfWeek time.Weekday) Adjuster {
+	return DayOfWeekInMonth(-1, dayOfWeek)
+}
+
+// Next returns an Adjuster to the first occurrence of the given day of week after the date.
+func Next(dayOfWeek time.Weekday) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		diff := (int(dayOfWeek) - int(ld.Weekday()) + 7) % 7
+		if diff == 0 {
+			diff = 7
+		}
+
+		return ld.PlusDays(diff)
+	}
+}
+
+// NextOrSame returns an Adjuster to the first occurrence of the given day of week on or after the date.
+func NextOrSame(dayOfWeek time.Weekday) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return ld.PlusDays((int(dayOfWeek) - int(ld.Weekday()) + 7) % 7)
+	}
+}
+
+// Previous returns an Adjuster to the last occurrence of the given day of week before the date.
+func Previous(dayOfWeek time.Weekday) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		diff := (int(ld.Weekday()) - int(dayOfWeek) + 7) % 7
+		if diff == 0 {
+			diff = 7
+		}
+
+		return ld.MinusDays(diff)
+	}
+}
+
+// PreviousOrSame returns an Adjuster to the last occurrence of the given day of week on or before the date.
+func PreviousOrSame(dayOfWeek time.Weekday) Adjuster {
+	return func(ld LocalDate) LocalDate {
+		return ld.MinusDays((int(ld.Weekday()) - int(dayOfWeek) + 7) % 7)
+	}
+}
+
+// With returns a copy of the LocalDate adjusted by the given Adjuster,
+// e.g. ld.With(LastInMonth(time.Friday)) returns the last Friday of the month of ld.
+func (ld LocalDate) With(adjuster Adjuster) LocalDate {
+	return adjuster(ld)
+}
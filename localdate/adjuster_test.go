@@ -0,0 +1,57 @@
+package localdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAdjusters(t *testing.T) {
+	t.Parallel()
+
+	// 2024-02-14 is a Wednesday.
+	ld := New(2024, time.February, 14)
+
+	tests := map[string]struct {
+		adjuster Adjuster
+		expect   LocalDate
+	}{
+		"FirstDayOfMonth":          {adjuster: FirstDayOfMonth(), expect: New(2024, time.February, 1)},
+		"LastDayOfMonth":           {adjuster: LastDayOfMonth(), expect: New(2024, time.February, 29)},
+		"FirstDayOfNextMonth":      {adjuster: FirstDayOfNextMonth(), expect: New(2024, time.March, 1)},
+		"FirstDayOfYear":           {adjuster: FirstDayOfYear(), expect: New(2024, time.January, 1)},
+		"LastDayOfYear":            {adjuster: LastDayOfYear(), expect: New(2024, time.December, 31)},
+		"FirstDayOfNextYear":       {adjuster: FirstDayOfNextYear(), expect: New(2025, time.January, 1)},
+		"Next same weekday":        {adjuster: Next(time.Wednesday), expect: New(2024, time.February, 21)},
+		"Next other weekday":       {adjuster: Next(time.Friday), expect: New(2024, time.February, 16)},
+		"NextOrSame same weekday":  {adjuster: NextOrSame(time.Wednesday), expect: ld},
+		"NextOrSame other weekday": {adjuster: NextOrSame(time.Tuesday), expect: New(2024, time.February, 20)},
+		"Previous same weekday":    {adjuster: Previous(time.Wednesday), expect: New(2024, time.February, 7)},
+		"Previous other weekday":   {adjuster: Previous(time.Friday), expect: New(2024, time.February, 9)},
+		"PreviousOrSame same":      {adjuster: PreviousOrSame(time.Wednesday), expect: ld},
+		"PreviousOrSame other":     {adjuster: PreviousOrSame(time.Thursday), expect: New(2024, time.February, 8)},
+		"FirstInMonth":             {adjuster: FirstInMonth(time.Monday), expect: New(2024, time.February, 5)},
+		"FirstInMonth on the 1st":  {adjuster: FirstInMonth(time.Thursday), expect: New(2024, time.February, 1)},
+		"LastInMonth":              {adjuster: LastInMonth(time.Friday), expect: New(2024, time.February, 23)},
+		"LastInMonth on the last":  {adjuster: LastInMonth(time.Thursday), expect: New(2024, time.February, 29)},
+		"2nd Tuesday":              {adjuster: DayOfWeekInMonth(2, time.Tuesday), expect: New(2024, time.February, 13)},
+		"5th Thursday":             {adjuster: DayOfWeekInMonth(5, time.Thursday), expect: New(2024, time.February, 29)},
+		"5th Friday overflows":     {adjuster: DayOfWeekInMonth(5, time.Friday), expect: New(2024, time.March, 1)},
+		"2nd to last Friday":       {adjuster: DayOfWeekInMonth(-2, time.Friday), expect: New(2024, time.February, 16)},
+		"0th is last of previous":  {adjuster: DayOfWeekInMonth(0, time.Friday), expect: New(2024, time.January, 26)},
+		"Chain": {
+			adjuster: Chain(FirstDayOfNextMonth(), NextOrSame(time.Monday)),
+			expect:   New(2024, time.March, 4),
+		},
+		"Empty chain": {adjuster: Chain(), expect: ld},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ld.With(test.adjuster); got != test.expect {
+				t.Errorf("With: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
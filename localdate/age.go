@@ -0,0 +1,78 @@
+package localdate
+
+import (
+	"time"
+)
+
+const (
+	// LeapDayFeb28 moves February 29th to February 28th in non leap years, as birthdays in New Zealand or Taiwan.
+	LeapDayFeb28 LeapDayPolicy = iota
+	// LeapDayMar1 moves February 29th to March 1st in non leap years, as birthdays in the United Kingdom or
+	// Hong Kong.
+	LeapDayMar1
+	// LeapDaySkip skips February 29th in non leap years, so it only occurs in leap years.
+	// Age and CompletedYears count the year as completed on March 1st, the same as LeapDayMar1.
+	LeapDaySkip
+)
+
+// LeapDayPolicy decides what happens with February 29th in non leap years.
+// The zero value is LeapDayFeb28.
+type LeapDayPolicy int
+
+// Age returns the age on the date on of someone born on birth, as completed years plus the months and days since
+// the most recent birthday.
+// Birthdays on February 29th are moved in non leap years according to the policy.
+// Returns zero values if on is before birth.
+func Age(birth, on LocalDate, policy LeapDayPolicy) (int, int, int) {
+	if on.Before(birth) {
+		return 0, 0, 0
+	}
+
+	years := CompletedYears(birth, on, policy)
+	year := birth.Year() + years
+	lastBirthday := birthday(birth, year, policy)
+
+	// Monthly anniversaries fall on the day of birth, or the last day of shorter months.
+	last, months := lastBirthday, 0
+	for months < 11 {
+		first := New(year, birth.Month(), 1).PlusMonths(months + 1)
+		next := New(first.Year(), first.Month(), min(birth.Day(), first.LengthOfMonth()))
+		if next.After(on) {
+			break
+		}
+
+		last, months = next, months+1
+	}
+
+	return years, months, int(DaysBetween(last, on))
+}
+
+// CompletedYears returns the number of birthdays of someone born on birth that have happened on or before the date on.
+// Birthdays on February 29th are moved in non leap years according to the policy.
+// Returns zero if on is before birth.
+func CompletedYears(birth, on LocalDate, policy LeapDayPolicy) int {
+	if on.Before(birth) {
+		return 0
+	}
+
+	years := on.Year() - birth.Year()
+	if birthday(birth, on.Year(), policy).After(on) {
+		years--
+	}
+
+	return years
+}
+
+// birthday returns the birthday in the year of someone born on birth.
+// LeapDaySkip is resolved as LeapDayMar1, so someone born on February 29th still ages every year.
+func birthday(birth LocalDate, year int, policy LeapDayPolicy) LocalDate {
+	if birth.Month() != time.February || birth.Day() != 29 || isLeap(year) {
+		return New(year, birth.Month(), birth.Day())
+	}
+
+	if policy == LeapDayFeb28 {
+		return New(year, time.February, 28)
+	}
+
+	return New(year, time.March, 1)
+}
@@ -0,0 +1,103 @@
+package localdate
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAge(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		birth, on LocalDate
+		policy    LeapDayPolicy
+		want      [3]int
+	}{
+		"Birth day": {
+			birth: New(1990, time.May, 15),
+			on:    New(1990, time.May, 15),
+			want:  [3]int{0, 0, 0},
+		},
+		"Day before the birthday": {
+			birth: New(1990, time.May, 15),
+			on:    New(2024, time.May, 14),
+			want:  [3]int{33, 11, 29},
+		},
+		"On the birthday": {
+			birth: New(1990, time.May, 15),
+			on:    New(2024, time.May, 15),
+			want:  [3]int{34, 0, 0},
+		},
+		"Months across the year end": {
+			birth: New(1990, time.October, 20),
+			on:    New(2025, time.January, 25),
+			want:  [3]int{34, 3, 5},
+		},
+		"Born on the 31st": {
+			birth: New(2000, time.January, 31),
+			on:    New(2023, time.March, 1),
+			want:  [3]int{23, 1, 1},
+		},
+		"Leap day birth, Mar 1, day before": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2023, time.February, 28),
+			policy: LeapDayMar1,
+			want:   [3]int{18, 11, 30},
+		},
+		"Leap day birth, Mar 1, on the birthday": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2023, time.March, 1),
+			policy: LeapDayMar1,
+			want:   [3]int{19, 0, 0},
+		},
+		"Leap day birth, Feb 28, on the birthday": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2023, time.February, 28),
+			policy: LeapDayFeb28,
+			want:   [3]int{19, 0, 0},
+		},
+		"Leap day birth, Feb 28, day before a leap birthday": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2024, time.February, 28),
+			policy: LeapDayFeb28,
+			want:   [3]int{19, 11, 30},
+		},
+		"Leap day birth, monthly anniversary after Mar 1": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2023, time.March, 29),
+			policy: LeapDayMar1,
+			want:   [3]int{19, 1, 0},
+		},
+		"Leap day birth, skip, ages on Mar 1": {
+			birth:  New(2004, time.February, 29),
+			on:     New(2023, time.March, 1),
+			policy: LeapDaySkip,
+			want:   [3]int{19, 0, 0},
+		},
+		"Leap day birth, zero policy is Feb 28": {
+			birth: New(2004, time.February, 29),
+			on:    New(2023, time.February, 28),
+			want:  [3]int{19, 0, 0},
+		},
+		"On before birth": {
+			birth: New(2024, time.May, 15),
+			on:    New(2024, time.May, 14),
+			want:  [3]int{0, 0, 0},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			years, months, days := Age(test.birth, test.on, test.policy)
+			if got := [3]int{years, months, days}; got != test.want {
+				t.Errorf("Age(%v, %v) = %v, want %v", test.birth, test.on, got, test.want)
+			}
+
+			if got := CompletedYears(test.birth, test.on, test.policy); got != test.want[0] {
+				t.Errorf("CompletedYears(%v, %v) = %d, want %d", test.birth, test.on, got, test.want[0])
+			}
+		})
+	}
+}
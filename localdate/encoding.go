@@ -0,0 +1,36 @@
+package localdate
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = LocalDate{}
+	_ encoding.TextUnmarshaler = (*LocalDate)(nil)
+	_ json.Marshaler           = LocalDate{}
+	_ json.Unmarshaler         = (*LocalDate)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the LocalDate as an ISO-8601 string, e.g. "2024-02-29".
+func (ld LocalDate) MarshalJSON() ([]byte, error) {
+	return codec.MarshalJSON(len("+YYYYY-MM-DD"), ld.appendFormat), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the LocalDate in the ISO-8601 extended format.
+func (ld LocalDate) MarshalText() ([]byte, error) {
+	return ld.appendFormat(make([]byte, 0, len("+YYYYY-MM-DD"))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting any of the formats supported by Parse.
+// A JSON null leaves the LocalDate unchanged.
+func (ld *LocalDate) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "localdate: LocalDate", ld.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any of the formats supported by Parse.
+func (ld *LocalDate) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(ld, data, Parse)
+}
@@ -0,0 +1,172 @@
+package localdate
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type person struct {
+	BirthDate LocalDate  `json:"birthDate"`
+	DeathDate *LocalDate `json:"deathDate"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value  person
+		expect string
+	}{
+		"Date and null pointer": {
+			value:  person{BirthDate: New(1815, time.December, 10)},
+			expect: `{"birthDate":"1815-12-10","deathDate":null}`,
+		},
+		"Both dates": {
+			value:  person{BirthDate: New(1815, time.December, 10), DeathDate: ptr(New(1852, time.November, 27))},
+			expect: `{"birthDate":"1815-12-10","deathDate":"1852-11-27"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(test.value)
+			if err != nil {
+				t.Fatalf("json.Marshal: unexpected error %v", err)
+			}
+
+			if string(got) != test.expect {
+				t.Errorf("json.Marshal: expected %s, got %s", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		data    string
+		expect  person
+		wantErr bool
+	}{
+		"Date and null": {
+			data:   `{"birthDate":"1815-12-10","deathDate":null}`,
+			expect: person{BirthDate: New(1815, time.December, 10)},
+		},
+		"Both dates": {
+			data:   `{"birthDate":"1815-12-10","deathDate":"1852-11-27"}`,
+			expect: person{BirthDate: New(1815, time.December, 10), DeathDate: ptr(New(1852, time.November, 27))},
+		},
+		"Null date keeps zero value": {
+			data:   `{"birthDate":null}`,
+			expect: person{},
+		},
+		"Week date": {
+			data:   `{"birthDate":"2024-W09-4"}`,
+			expect: person{BirthDate: New(2024, time.February, 29)},
+		},
+		"Not a string": {
+			data:    `{"birthDate":20240229}`,
+			wantErr: true,
+		},
+		"Invalid date": {
+			data:    `{"birthDate":"2023-02-29"}`,
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got person
+
+			err := json.Unmarshal([]byte(test.data), &got)
+			if test.wantErr {
+				if err == nil {
+					t.Fatalf("json.Unmarshal: expected error, got %v", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("json.Unmarshal: unexpected error %v", err)
+			}
+
+			if diff := cmp.Diff(test.expect, got, cmp.AllowUnexported(LocalDate{})); diff != "" {
+				t.Errorf("json.Unmarshal: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ld := New(-44, time.March, 15)
+
+	text, err := ld.MarshalText()
+	if err != nil || string(text) != "-0044-03-15" {
+		t.Fatalf("MarshalText: expected %q, got %q, %v", "-0044-03-15", text, err)
+	}
+
+	var got LocalDate
+	if err = got.UnmarshalText(text); err != nil || got != ld {
+		t.Errorf("UnmarshalText: expected %v, got %v, %v", ld, got, err)
+	}
+
+	var parseErr *ParseError
+	if err = got.UnmarshalText([]byte("15/03/-44")); !errors.As(err, &parseErr) {
+		t.Errorf("UnmarshalText: expected *ParseError, got %v", err)
+	}
+}
+
+func TestTextRoundTripBoundaries(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld   LocalDate
+		text string
+	}{
+		"More than 9 digits": {
+			ld:   Normalize(300_000_000_000, time.February, 30),
+			text: "+300000000000-03-01",
+		},
+		"Maximum year": {
+			ld:   New(math.MaxInt, time.December, 31),
+			text: "+9223372036854775807-12-31",
+		},
+		"Minimum year": {
+			ld:   New(math.MinInt, time.January, 1),
+			text: "-9223372036854775808-01-01",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(test.ld)
+			if err != nil || string(data) != `"`+test.text+`"` {
+				t.Fatalf("json.Marshal: expected %q, got %s, %v", test.text, data, err)
+			}
+
+			var got LocalDate
+			if err = json.Unmarshal(data, &got); err != nil || got != test.ld {
+				t.Errorf("json.Unmarshal: expected %v, got %v, %v", test.ld, got, err)
+			}
+		})
+	}
+}
+
+func ptr[T any](t T) *T {
+	return &t
+}
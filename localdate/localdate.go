@@ -2,70 +2,326 @@
 // Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/LocalDate.html.
 package localdate
 
-import "time"
+import (
+	"cmp"
+	"fmt"
+	"time"
 
-var _ LocalDate = new(localDate)
+	"github.com/manuelarte/gotimeplus/clock"
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
 
-type (
-	LocalDate interface {
-		// After reports whether the LocalDate is after the given other LocalDate.
-		After(other LocalDate) bool
-		// Before reports whether the LocalDate is before the given other LocalDate.
-		Before(other LocalDate) bool
-		Day() int
-		// Equal reports whether the LocalDate is equal to the given other LocalDate.
-		Equal(other LocalDate) bool
-		Month() time.Month
-		// ToTime converts the LocalDate to a time.Time at midnight in the provided location.
-		ToTime(loc *time.Location) time.Time
-		Year() int
-	}
+var _ fmt.Stringer = LocalDate{}
 
-	localDate struct {
+type (
+	// LocalDate is a date without a time-zone, e.g. 2024-02-29.
+	// It is a comparable value, so it can be used with == and as a map key.
+	// The zero value is January 1, year 1, the same date as the zero time.Time.
+	LocalDate struct {
+		// The fields are stored as offsets from January 1, year 1, so the zero value is a valid date.
 		year  int
 		month time.Month
 		day   int
 	}
+
+	// RangeError is returned by Of when a field of the date is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, e.g. "month" or "day".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
 )
 
 // New LocalDate from year, month and day.
+// The values are not validated, use Of to reject dates like February 30th, or Normalize to roll them over.
 func New(year int, month time.Month, day int) LocalDate {
-	return &localDate{
-		year:  year,
-		month: month,
-		day:   day,
+	return LocalDate{
+		year:  year - 1,
+		month: month - time.January,
+		day:   day - 1,
 	}
 }
 
+// Of LocalDate from year, month and day.
+// Returns a *RangeError if the month is not in [1, 12] or the day does not exist in that month.
+func Of(year int, month time.Month, day int) (LocalDate, error) {
+	if month < time.January || month > time.December {
+		return LocalDate{}, &RangeError{Field: "month", Value: int(month), Min: int(time.January), Max: int(time.December)}
+	}
+
+	if maxDay := daysIn(year, month); day < 1 || day > maxDay {
+		return LocalDate{}, &RangeError{Field: "day", Value: day, Min: 1, Max: maxDay}
+	}
+
+	return New(year, month, day), nil
+}
+
+// Normalize LocalDate from year, month and day, rolling out of range values over the same way time.Date does,
+// e.g. February 30th becomes March 1st (or 2nd in non leap years).
+func Normalize(year int, month time.Month, day int) LocalDate {
+	return OfEpochDay(toEpochDay(year, month, day))
+}
+
+// OfEpochDay LocalDate from the number of days since 1970-01-01, in the proleptic Gregorian calendar.
+func OfEpochDay(epochDay int64) LocalDate {
+	return New(fromEpochDay(epochDay))
+}
+
 // FromTime converts time.Time to LocalDate.
 func FromTime(t time.Time) LocalDate {
 	return New(t.Year(), t.Month(), t.Day())
 }
 
-func (ld localDate) After(other LocalDate) bool {
-	return ld.ToTime(time.UTC).After(other.ToTime(time.UTC))
+// Now returns the current date of the clock in the location.
+func Now(c clock.Clock, loc *time.Location) LocalDate {
+	return FromTime(c.Now().In(loc))
+}
+
+// After reports whether the LocalDate is after the given other LocalDate.
+func (ld LocalDate) After(other LocalDate) bool {
+	return ld.Compare(other) > 0
+}
+
+// Before reports whether the LocalDate is before the given other LocalDate.
+func (ld LocalDate) Before(other LocalDate) bool {
+	return ld.Compare(other) < 0
+}
+
+// Compare returns -1 if the LocalDate is before other, 0 if they are equal, and +1 if it's after.
+// It can be used with slices.SortFunc, e.g. slices.SortFunc(dates, localdate.LocalDate.Compare).
+func (ld LocalDate) Compare(other LocalDate) int {
+	switch {
+	case ld.year != other.year:
+		return cmp.Compare(ld.year, other.year)
+	case ld.month != other.month:
+		return cmp.Compare(ld.month, other.month)
+	default:
+		return cmp.Compare(ld.day, other.day)
+	}
 }
 
-func (ld localDate) Before(other LocalDate) bool {
-	return ld.ToTime(time.UTC).Before(other.ToTime(time.UTC))
+func (ld LocalDate) Day() int {
+	return ld.day + 1
 }
 
-func (ld localDate) Day() int {
-	return ld.day
+// DayOfYear returns the day of the year, from 1 to 365 or 366 in leap years.
+func (ld LocalDate) DayOfYear() int {
+	return int(ld.ToEpochDay()-toEpochDay(ld.Year(), time.January, 1)) + 1
 }
 
-func (ld localDate) Equal(other LocalDate) bool {
-	return ld.ToTime(time.UTC).Equal(other.ToTime(time.UTC))
+// Equal reports whether the LocalDate is equal to the given other LocalDate.
+// It's the same as using ==.
+func (ld LocalDate) Equal(other LocalDate) bool {
+	return ld == other
 }
 
-func (ld localDate) Month() time.Month {
-	return ld.month
+// ISOWeek returns the ISO-8601 week-based year and week number of the LocalDate, same as time.Time.ISOWeek.
+// Weeks start on Monday, and week 1 is the week containing the first Thursday of the year,
+// so days around the start of January can belong to the last week of the previous year, and vice versa.
+func (ld LocalDate) ISOWeek() (int, int) {
+	return ISOWeekFields.weekBasedYearAndWeek(ld)
 }
 
-func (ld localDate) ToTime(loc *time.Location) time.Time {
-	return time.Date(ld.year, ld.month, ld.day, 0, 0, 0, 0, loc)
+// IsLeapYear reports whether the year of the LocalDate is a leap year in the proleptic Gregorian calendar.
+func (ld LocalDate) IsLeapYear() bool {
+	return isLeap(ld.Year())
 }
 
-func (ld localDate) Year() int {
-	return ld.year
+// IsZero reports whether the LocalDate is the zero value, January 1, year 1.
+func (ld LocalDate) IsZero() bool {
+	return ld == LocalDate{}
+}
+
+// LengthOfMonth returns the number of days in the month of the LocalDate, from 28 to 31.
+func (ld LocalDate) LengthOfMonth() int {
+	return daysIn(ld.Year(), ld.Month())
+}
+
+// LengthOfYear returns the number of days in the year of the LocalDate, 365 or 366.
+func (ld LocalDate) LengthOfYear() int {
+	return daysInYear(ld.Year())
+}
+
+// MinusDays returns a copy of the LocalDate with the given number of days subtracted.
+func (ld LocalDate) MinusDays(days int) LocalDate {
+	return ld.PlusDays(-days)
+}
+
+// MinusMonths returns a copy of the LocalDate with the given number of months subtracted,
+// clamping the day to the last valid day of the resulting month.
+func (ld LocalDate) MinusMonths(months int) LocalDate {
+	return ld.PlusMonths(-months)
+}
+
+// MinusWeeks returns a copy of the LocalDate with the given number of weeks subtracted.
+func (ld LocalDate) MinusWeeks(weeks int) LocalDate {
+	return ld.PlusWeeks(-weeks)
+}
+
+// MinusYears returns a copy of the LocalDate with the given number of years subtracted,
+// clamping February 29th to February 28th in non leap years.
+func (ld LocalDate) MinusYears(years int) LocalDate {
+	return ld.PlusYears(-years)
+}
+
+func (ld LocalDate) Month() time.Month {
+	return ld.month + time.January
+}
+
+// PlusDays returns a copy of the LocalDate with the given number of days added.
+func (ld LocalDate) PlusDays(days int) LocalDate {
+	return OfEpochDay(ld.ToEpochDay() + int64(days))
+}
+
+// PlusMonths returns a copy of the LocalDate with the given number of months added,
+// clamping the day to the last valid day of the resulting month, e.g. Jan 31st + 1 month = Feb 29th.
+func (ld LocalDate) PlusMonths(months int) LocalDate {
+	total := ld.Year()*12 + int(ld.month) + months
+	year := arith.FloorDiv(total, 12)
+	month := time.Month(total-year*12) + time.January
+
+	return New(year, month, min(ld.Day(), daysIn(year, month)))
+}
+
+// PlusWeeks returns a copy of the LocalDate with the given number of weeks added.
+func (ld LocalDate) PlusWeeks(weeks int) LocalDate {
+	return ld.PlusDays(weeks * 7)
+}
+
+// PlusYears returns a copy of the LocalDate with the given number of years added,
+// clamping February 29th to February 28th in non leap years.
+func (ld LocalDate) PlusYears(years int) LocalDate {
+	year := ld.Year() + years
+
+	return New(year, ld.Month(), min(ld.Day(), daysIn(year, ld.Month())))
+}
+
+// Quarter returns the quarter of the year of the LocalDate, from 1 to 4.
+func (ld LocalDate) Quarter() int {
+	return int(ld.month)/3 + 1
+}
+
+// String returns the LocalDate in the ISO-8601 extended format, e.g. 2024-02-29.
+// Years outside [0, 9999] are prefixed with their sign, e.g. +12024-01-01.
+func (ld LocalDate) String() string {
+	return string(ld.appendFormat(make([]byte, 0, len("+YYYYY-MM-DD"))))
+}
+
+// ToEpochDay returns the number of days since 1970-01-01, negative for dates before it.
+// It uses the proleptic Gregorian calendar and does not depend on any time-zone,
+// so it works for years far outside the range time.Time handles accurately.
+func (ld LocalDate) ToEpochDay() int64 {
+	return toEpochDay(ld.Year(), ld.Month(), ld.Day())
+}
+
+// ToTime converts the LocalDate to a time.Time at midnight in the provided location.
+func (ld LocalDate) ToTime(loc *time.Location) time.Time {
+	return time.Date(ld.Year(), ld.Month(), ld.Day(), 0, 0, 0, 0, loc)
+}
+
+// Weekday returns the day of the week of the LocalDate.
+func (ld LocalDate) Weekday() time.Weekday {
+	return weekdayOf(ld.ToEpochDay())
+}
+
+func (ld LocalDate) Year() int {
+	return ld.year + 1
+}
+
+func (ld LocalDate) appendFormat(b []byte) []byte {
+	b = codec.AppendYear(b, ld.Year())
+	b = append(b, '-')
+	b = codec.AppendInt(b, int(ld.Month()), 2)
+	b = append(b, '-')
+
+	return codec.AppendInt(b, ld.Day(), 2)
+}
+
+// DaysBetween returns the number of days from a to b, negative if b is before a.
+func DaysBetween(a, b LocalDate) int64 {
+	return b.ToEpochDay() - a.ToEpochDay()
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("localdate: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
+
+// daysIn returns the number of days of the month in the given year.
+func daysIn(year int, month time.Month) int {
+	switch month {
+	case time.February:
+		if isLeap(year) {
+			return 29
+		}
+
+		return 28
+	case time.April, time.June, time.September, time.November:
+		return 30
+	default:
+		return 31
+	}
+}
+
+// daysInYear returns the number of days in the given year.
+func daysInYear(year int) int {
+	if isLeap(year) {
+		return 366
+	}
+
+	return 365
+}
+
+// weekdayOf returns the day of the week of the epoch day.
+func weekdayOf(epochDay int64) time.Weekday {
+	// 1970-01-01 was a Thursday.
+	return time.Weekday(epochDay + 4 - arith.FloorDiv(epochDay+4, 7)*7)
+}
+
+// isLeap reports whether the year is a leap year in the proleptic Gregorian calendar.
+func isLeap(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// toEpochDay returns the number of days since 1970-01-01 in the proleptic Gregorian calendar.
+// Months and days out of range are carried over, so the result is the same as Normalize.
+func toEpochDay(year int, month time.Month, day int) int64 {
+	// Fold the month into [1, 12] first.
+	m := int64(month) - 1
+	y := int64(year) + arith.FloorDiv(m, 12)
+	m = m - arith.FloorDiv(m, 12)*12 + 1
+
+	// Count years from March so that the leap day is the last day of the year.
+	if m <= 2 {
+		y--
+	}
+
+	era := arith.FloorDiv(y, 400)
+	yearOfEra := y - era*400
+	dayOfYear := (153*((m+9)%12)+2)/5 + int64(day) - 1
+	dayOfEra := yearOfEra*365 + yearOfEra/4 - yearOfEra/100 + dayOfYear
+
+	return era*146097 + dayOfEra - 719468
+}
+
+// fromEpochDay is the inverse of toEpochDay.
+func fromEpochDay(epochDay int64) (int, time.Month, int) {
+	z := epochDay + 719468
+	era := arith.FloorDiv(z, 146097)
+	dayOfEra := z - era*146097
+	yearOfEra := (dayOfEra - dayOfEra/1460 + dayOfEra/36524 - dayOfEra/146096) / 365
+	dayOfYear := dayOfEra - (365*yearOfEra + yearOfEra/4 - yearOfEra/100)
+	mp := (5*dayOfYear + 2) / 153
+	day := dayOfYear - (153*mp+2)/5 + 1
+	month := (mp+2)%12 + 1
+
+	year := yearOfEra + era*400
+	if month <= 2 {
+		year++
+	}
+
+	return int(year), time.Month(month), int(day)
 }
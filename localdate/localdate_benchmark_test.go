@@ -0,0 +1,87 @@
+package localdate
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:gochecknoglobals // sinks to prevent the compiler from optimising the benchmarks away.
+var (
+	sinkBool bool
+	sinkDate LocalDate
+)
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		sinkDate = New(2024, time.February, i%28+1)
+	}
+}
+
+// BenchmarkBefore compares the field-wise comparison against the former implementation,
+// that converted both dates to time.Time.
+func BenchmarkBefore(b *testing.B) {
+	x, y := New(2024, time.February, 28), New(2024, time.February, 29)
+
+	b.Run("field-wise", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.Before(y)
+		}
+	})
+
+	b.Run("via time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.ToTime(time.UTC).Before(y.ToTime(time.UTC))
+		}
+	})
+}
+
+func BenchmarkEqual(b *testing.B) {
+	x, y := New(2024, time.February, 29), New(2024, time.February, 29)
+
+	b.Run("field-wise", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.Equal(y)
+		}
+	})
+
+	b.Run("via time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.ToTime(time.UTC).Equal(y.ToTime(time.UTC))
+		}
+	})
+}
+
+// BenchmarkMapKey compares using LocalDate as a map key against the former workaround of keying by its string.
+func BenchmarkMapKey(b *testing.B) {
+	ld := New(2024, time.February, 29)
+
+	b.Run("LocalDate", func(b *testing.B) {
+		cache := map[LocalDate]bool{ld: true}
+
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = cache[ld]
+		}
+	})
+
+	b.Run("string", func(b *testing.B) {
+		cache := map[string]bool{ld.String(): true}
+
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = cache[ld.String()]
+		}
+	})
+}
@@ -1,8 +1,13 @@
 package localdate
 
 import (
+	"errors"
 	"testing"
 	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/gotimeplus/clock"
 )
 
 func TestAfter(t *testing.T) {
@@ -75,6 +80,36 @@ func TestBefore(t *testing.T) {
 	}
 }
 
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b   LocalDate
+		expect int
+	}{
+		"A is after B by year":   {a: New(2025, time.January, 1), b: New(2024, time.December, 31), expect: 1},
+		"A is after B by day":    {a: New(2024, time.July, 6), b: New(2024, time.July, 5), expect: 1},
+		"A is same as B":         {a: New(2024, time.July, 5), b: New(2024, time.July, 5), expect: 0},
+		"A is before B":          {a: New(2024, time.June, 30), b: New(2024, time.July, 1), expect: -1},
+		"Negative year":          {a: New(-1, time.December, 31), b: New(0, time.January, 1), expect: -1},
+		"Zero value is year 1st": {a: LocalDate{}, b: New(1, time.January, 1), expect: 0},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.expect {
+				t.Errorf("Compare: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.b.Compare(test.a); got != -test.expect {
+				t.Errorf("Compare reversed: expected %v, got %v", -test.expect, got)
+			}
+		})
+	}
+}
+
 func TestEqual(t *testing.T) {
 	t.Parallel()
 
@@ -114,3 +149,560 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year    int
+		month   time.Month
+		day     int
+		wantErr *RangeError
+	}{
+		"Valid date": {
+			year:  2024,
+			month: time.July,
+			day:   5,
+		},
+		"Leap day in leap year": {
+			year:  2024,
+			month: time.February,
+			day:   29,
+		},
+		"Leap day in non leap year": {
+			year:    2023,
+			month:   time.February,
+			day:     29,
+			wantErr: &RangeError{Field: "day", Value: 29, Min: 1, Max: 28},
+		},
+		"Leap day in century non leap year": {
+			year:    1900,
+			month:   time.February,
+			day:     29,
+			wantErr: &RangeError{Field: "day", Value: 29, Min: 1, Max: 28},
+		},
+		"February 30th": {
+			year:    2024,
+			month:   time.February,
+			day:     30,
+			wantErr: &RangeError{Field: "day", Value: 30, Min: 1, Max: 29},
+		},
+		"April 31st": {
+			year:    2024,
+			month:   time.April,
+			day:     31,
+			wantErr: &RangeError{Field: "day", Value: 31, Min: 1, Max: 30},
+		},
+		"Day zero": {
+			year:    2024,
+			month:   time.January,
+			day:     0,
+			wantErr: &RangeError{Field: "day", Value: 0, Min: 1, Max: 31},
+		},
+		"Month 13": {
+			year:    2024,
+			month:   13,
+			day:     1,
+			wantErr: &RangeError{Field: "month", Value: 13, Min: 1, Max: 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.year, test.month, test.day)
+			if test.wantErr != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("Of: expected *RangeError, got %v", err)
+				}
+
+				if diff := cmp.Diff(test.wantErr, rangeErr); diff != "" {
+					t.Errorf("Of: error mismatch (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Of: unexpected error %v", err)
+			}
+
+			if !got.Equal(New(test.year, test.month, test.day)) {
+				t.Errorf("Of: expected %d-%d-%d, got %v", test.year, test.month, test.day, got)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year   int
+		month  time.Month
+		day    int
+		expect LocalDate
+	}{
+		"Valid date is kept": {
+			year:   2024,
+			month:  time.July,
+			day:    5,
+			expect: New(2024, time.July, 5),
+		},
+		"February 30th in leap year": {
+			year:   2024,
+			month:  time.February,
+			day:    30,
+			expect: New(2024, time.March, 1),
+		},
+		"February 30th in non leap year": {
+			year:   2023,
+			month:  time.February,
+			day:    30,
+			expect: New(2023, time.March, 2),
+		},
+		"Month 13": {
+			year:   2024,
+			month:  13,
+			day:    1,
+			expect: New(2025, time.January, 1),
+		},
+		"Day zero": {
+			year:   2024,
+			month:  time.March,
+			day:    0,
+			expect: New(2024, time.February, 29),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Normalize(test.year, test.month, test.day)
+			if got.Year() != test.expect.Year() || got.Month() != test.expect.Month() || got.Day() != test.expect.Day() {
+				t.Errorf("Normalize: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestPlusDays(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld     LocalDate
+		days   int
+		expect LocalDate
+	}{
+		"Same month": {
+			ld:     New(2024, time.July, 5),
+			days:   10,
+			expect: New(2024, time.July, 15),
+		},
+		"Into leap day": {
+			ld:     New(2024, time.February, 28),
+			days:   1,
+			expect: New(2024, time.February, 29),
+		},
+		"Across year end": {
+			ld:     New(2023, time.December, 31),
+			days:   1,
+			expect: New(2024, time.January, 1),
+		},
+		"Negative days": {
+			ld:     New(2024, time.March, 1),
+			days:   -1,
+			expect: New(2024, time.February, 29),
+		},
+		"Before the common era": {
+			ld:     New(1, time.January, 1),
+			days:   -1,
+			expect: New(0, time.December, 31),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ld.PlusDays(test.days); !got.Equal(test.expect) {
+				t.Errorf("PlusDays: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.expect.MinusDays(test.days); !got.Equal(test.ld) {
+				t.Errorf("MinusDays: expected %v, got %v", test.ld, got)
+			}
+		})
+	}
+}
+
+func TestPlusDaysMatchesTimeAddDate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(1599, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ld := FromTime(start)
+
+	for days := range 365 * 850 {
+		want := start.AddDate(0, 0, days)
+		if got := ld.PlusDays(days); got.Year() != want.Year() || got.Month() != want.Month() || got.Day() != want.Day() {
+			t.Fatalf("PlusDays(%d): expected %v, got %d-%d-%d", days, want, got.Year(), got.Month(), got.Day())
+		}
+	}
+}
+
+func TestPlusWeeks(t *testing.T) {
+	t.Parallel()
+
+	ld := New(2024, time.February, 22)
+	if got, expect := ld.PlusWeeks(2), New(2024, time.March, 7); !got.Equal(expect) {
+		t.Errorf("PlusWeeks: expected %v, got %v", expect, got)
+	}
+
+	if got, expect := ld.MinusWeeks(8), New(2023, time.December, 28); !got.Equal(expect) {
+		t.Errorf("MinusWeeks: expected %v, got %v", expect, got)
+	}
+}
+
+func TestPlusMonths(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld     LocalDate
+		months int
+		expect LocalDate
+	}{
+		"Same day exists": {
+			ld:     New(2024, time.January, 15),
+			months: 1,
+			expect: New(2024, time.February, 15),
+		},
+		"Clamped to leap day": {
+			ld:     New(2024, time.January, 31),
+			months: 1,
+			expect: New(2024, time.February, 29),
+		},
+		"Clamped to February 28th": {
+			ld:     New(2023, time.January, 31),
+			months: 1,
+			expect: New(2023, time.February, 28),
+		},
+		"Clamped to 30th": {
+			ld:     New(2024, time.March, 31),
+			months: 1,
+			expect: New(2024, time.April, 30),
+		},
+		"Across year end": {
+			ld:     New(2024, time.November, 30),
+			months: 14,
+			expect: New(2026, time.January, 30),
+		},
+		"Negative months": {
+			ld:     New(2024, time.March, 31),
+			months: -1,
+			expect: New(2024, time.February, 29),
+		},
+		"Negative months across year start": {
+			ld:     New(2024, time.January, 31),
+			months: -2,
+			expect: New(2023, time.November, 30),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ld.PlusMonths(test.months); !got.Equal(test.expect) {
+				t.Errorf("PlusMonths: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.ld.MinusMonths(-test.months); !got.Equal(test.expect) {
+				t.Errorf("MinusMonths: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestPlusYears(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld     LocalDate
+		years  int
+		expect LocalDate
+	}{
+		"Regular date": {
+			ld:     New(2024, time.July, 5),
+			years:  1,
+			expect: New(2025, time.July, 5),
+		},
+		"Leap day to non leap year": {
+			ld:     New(2024, time.February, 29),
+			years:  1,
+			expect: New(2025, time.February, 28),
+		},
+		"Leap day to leap year": {
+			ld:     New(2024, time.February, 29),
+			years:  4,
+			expect: New(2028, time.February, 29),
+		},
+		"Negative years": {
+			ld:     New(2024, time.February, 29),
+			years:  -100,
+			expect: New(1924, time.February, 29),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ld.PlusYears(test.years); !got.Equal(test.expect) {
+				t.Errorf("PlusYears: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.ld.MinusYears(-test.years); !got.Equal(test.expect) {
+				t.Errorf("MinusYears: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var zero LocalDate
+	if !zero.IsZero() {
+		t.Errorf("IsZero: expected zero value to be zero")
+	}
+
+	if !zero.Equal(FromTime(time.Time{})) {
+		t.Errorf("Equal: expected zero value to be the date of the zero time.Time, got %v", zero)
+	}
+
+	if got := zero.String(); got != "0001-01-01" {
+		t.Errorf("String: expected %q, got %q", "0001-01-01", got)
+	}
+
+	if New(2024, time.January, 1).IsZero() {
+		t.Errorf("IsZero: expected 2024-01-01 not to be zero")
+	}
+}
+
+func TestComparable(t *testing.T) {
+	t.Parallel()
+
+	cache := map[LocalDate]string{
+		New(2024, time.February, 29): "leap day",
+	}
+
+	if got := cache[New(2024, time.February, 29)]; got != "leap day" {
+		t.Errorf("map lookup: expected %q, got %q", "leap day", got)
+	}
+
+	if New(2024, time.March, 1) != New(2024, time.February, 28).PlusDays(2) {
+		t.Errorf("==: expected 2024-02-28 + 2 days to be 2024-03-01")
+	}
+}
+
+func TestFieldsMatchTime(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(1899, time.December, 25, 0, 0, 0, 0, time.UTC)
+	for days := range 365 * 250 {
+		tt := start.AddDate(0, 0, days)
+		ld := FromTime(tt)
+
+		if got := ld.Weekday(); got != tt.Weekday() {
+			t.Fatalf("Weekday of %v: expected %v, got %v", ld, tt.Weekday(), got)
+		}
+
+		if got := ld.DayOfYear(); got != tt.YearDay() {
+			t.Fatalf("DayOfYear of %v: expected %v, got %v", ld, tt.YearDay(), got)
+		}
+	}
+}
+
+func TestCalendarFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld            LocalDate
+		leapYear      bool
+		lengthOfMonth int
+		lengthOfYear  int
+		quarter       int
+	}{
+		"Leap day": {
+			ld:            New(2024, time.February, 29),
+			leapYear:      true,
+			lengthOfMonth: 29,
+			lengthOfYear:  366,
+			quarter:       1,
+		},
+		"February in non leap year": {
+			ld:            New(2023, time.February, 1),
+			lengthOfMonth: 28,
+			lengthOfYear:  365,
+			quarter:       1,
+		},
+		"Century non leap year": {
+			ld:            New(1900, time.June, 30),
+			lengthOfMonth: 30,
+			lengthOfYear:  365,
+			quarter:       2,
+		},
+		"Quadricentennial leap year": {
+			ld:            New(2000, time.September, 1),
+			leapYear:      true,
+			lengthOfMonth: 30,
+			lengthOfYear:  366,
+			quarter:       3,
+		},
+		"End of year": {
+			ld:            New(2024, time.December, 31),
+			leapYear:      true,
+			lengthOfMonth: 31,
+			lengthOfYear:  366,
+			quarter:       4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ld.IsLeapYear(); got != test.leapYear {
+				t.Errorf("IsLeapYear: expected %v, got %v", test.leapYear, got)
+			}
+
+			if got := test.ld.LengthOfMonth(); got != test.lengthOfMonth {
+				t.Errorf("LengthOfMonth: expected %v, got %v", test.lengthOfMonth, got)
+			}
+
+			if got := test.ld.LengthOfYear(); got != test.lengthOfYear {
+				t.Errorf("LengthOfYear: expected %v, got %v", test.lengthOfYear, got)
+			}
+
+			if got := test.ld.Quarter(); got != test.quarter {
+				t.Errorf("Quarter: expected %v, got %v", test.quarter, got)
+			}
+		})
+	}
+}
+
+func TestEpochDay(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld       LocalDate
+		epochDay int64
+	}{
+		"Epoch": {
+			ld:       New(1970, time.January, 1),
+			epochDay: 0,
+		},
+		"Day before epoch": {
+			ld:       New(1969, time.December, 31),
+			epochDay: -1,
+		},
+		"Leap day": {
+			ld:       New(2024, time.February, 29),
+			epochDay: 19782,
+		},
+		"Zero value": {
+			ld:       LocalDate{},
+			epochDay: -719162,
+		},
+		"Far future": {
+			ld:       New(1_000_000_000, time.January, 1),
+			epochDay: 10957 + (1_000_000_000-2000)/400*146097, // 2000-01-01 plus whole Gregorian cycles.
+		},
+		"Far past": {
+			ld:       New(-1_000_000_000, time.January, 1),
+			epochDay: 10957 - (2000+1_000_000_000)/400*146097,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ld.ToEpochDay(); got != test.epochDay {
+				t.Errorf("ToEpochDay: expected %d, got %d", test.epochDay, got)
+			}
+
+			if got := OfEpochDay(test.epochDay); got != test.ld {
+				t.Errorf("OfEpochDay: expected %v, got %v", test.ld, got)
+			}
+		})
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b   LocalDate
+		expect int64
+	}{
+		"Same day": {
+			a:      New(2024, time.February, 29),
+			b:      New(2024, time.February, 29),
+			expect: 0,
+		},
+		"Leap year": {
+			a:      New(2024, time.January, 1),
+			b:      New(2025, time.January, 1),
+			expect: 366,
+		},
+		"Backwards": {
+			a:      New(2024, time.March, 1),
+			b:      New(2024, time.February, 28),
+			expect: -2,
+		},
+		"Gregorian cycle": {
+			a:      New(-400, time.January, 1),
+			b:      New(0, time.January, 1),
+			expect: 146097,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := DaysBetween(test.a, test.b); got != test.expect {
+				t.Errorf("DaysBetween: expected %d, got %d", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestNow(t *testing.T) {
+	t.Parallel()
+
+	c := clock.Fixed(time.Date(2024, time.March, 5, 23, 30, 15, 0, time.UTC))
+
+	tests := map[string]struct {
+		loc  *time.Location
+		want LocalDate
+	}{
+		"UTC":           {loc: time.UTC, want: New(2024, time.March, 5)},
+		"Next day east": {loc: time.FixedZone("UTC+9", 9*3600), want: New(2024, time.March, 6)},
+		"Same day west": {loc: time.FixedZone("UTC-5", -5*3600), want: New(2024, time.March, 5)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Now(c, test.loc); got != test.want {
+				t.Errorf("Now = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
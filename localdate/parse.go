@@ -0,0 +1,152 @@
+package localdate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+// ErrSyntax indicates that a value does not have any of the ISO-8601 date formats supported by Parse.
+var ErrSyntax = errors.New("invalid ISO-8601 date syntax")
+
+// ParseError describes a problem parsing a LocalDate.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure, either ErrSyntax or a *RangeError.
+	Err error
+}
+
+// Parse a LocalDate from one of the following ISO-8601 formats:
+//   - calendar date, extended 2024-02-29 or basic 20240229.
+//   - ordinal date, extended 2024-060 or basic 2024060.
+//   - week date, extended 2024-W09-4 or basic 2024W094.
+//   - any of the extended formats with an expanded year of any number of digits, e.g. +12024-01-01 or -0001-060,
+//     so every date formatted by String can be parsed back.
+//
+// Returns a *ParseError if the value can't be parsed or the date does not exist.
+func Parse(s string) (LocalDate, error) {
+	ld, err := parse(s)
+	if err != nil {
+		return LocalDate{}, &ParseError{Value: s, Err: err}
+	}
+
+	return ld, nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("localdate: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parse(s string) (LocalDate, error) {
+	year, rest, extended, ok := parseYear(s)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	switch {
+	case extended && len(rest) == len("MM-DD") && rest[2] == '-':
+		return parseCalendarDate(year, rest[:2], rest[3:])
+	case !extended && len(rest) == len("MMDD") && rest[0] != 'W':
+		return parseCalendarDate(year, rest[:2], rest[2:])
+	case len(rest) == len("DDD"):
+		return parseOrdinalDate(year, rest)
+	case extended && len(rest) == len("Www-D") && rest[0] == 'W' && rest[3] == '-':
+		return parseWeekDate(year, rest[1:3], rest[4:])
+	case !extended && len(rest) == len("WwwD") && rest[0] == 'W':
+		return parseWeekDate(year, rest[1:3], rest[3:])
+	default:
+		return LocalDate{}, ErrSyntax
+	}
+}
+
+// parseYear splits s into the year and the rest of the date, and reports whether it's in the extended format.
+func parseYear(s string) (int, string, bool, bool) {
+	if s == "" {
+		return 0, "", false, false
+	}
+
+	if s[0] == '+' || s[0] == '-' {
+		// Expanded years are only supported in the extended format, the basic one is ambiguous.
+		for i := 1; i < len(s); i++ {
+			if s[i] != '-' {
+				continue
+			}
+
+			year, ok := codec.ParseYear(s[:i])
+			if !ok {
+				return 0, "", false, false
+			}
+
+			return year, s[i+1:], true, true
+		}
+
+		return 0, "", false, false
+	}
+
+	if len(s) < 4 {
+		return 0, "", false, false
+	}
+
+	year, ok := codec.Atoi(s[:4])
+	if !ok {
+		return 0, "", false, false
+	}
+
+	if len(s) > 4 && s[4] == '-' {
+		return year, s[5:], true, true
+	}
+
+	return year, s[4:], false, true
+}
+
+func parseCalendarDate(year int, monthStr, dayStr string) (LocalDate, error) {
+	month, ok := codec.Atoi(monthStr)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	day, ok := codec.Atoi(dayStr)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	return Of(year, time.Month(month), day)
+}
+
+func parseOrdinalDate(year int, dayOfYearStr string) (LocalDate, error) {
+	dayOfYear, ok := codec.Atoi(dayOfYearStr)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	if maxDay := daysInYear(year); dayOfYear < 1 || dayOfYear > maxDay {
+		return LocalDate{}, &RangeError{Field: "day of year", Value: dayOfYear, Min: 1, Max: maxDay}
+	}
+
+	return New(year, time.January, 1).PlusDays(dayOfYear - 1), nil
+}
+
+func parseWeekDate(year int, weekStr, dayOfWeekStr string) (LocalDate, error) {
+	week, ok := codec.Atoi(weekStr)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	dayOfWeek, ok := codec.Atoi(dayOfWeekStr)
+	if !ok {
+		return LocalDate{}, ErrSyntax
+	}
+
+	if dayOfWeek < 1 || dayOfWeek > 7 {
+		return LocalDate{}, &RangeError{Field: "day of week", Value: dayOfWeek, Min: 1, Max: 7}
+	}
+
+	return ISOWeekFields.Date(year, week, time.Weekday(dayOfWeek%7))
+}
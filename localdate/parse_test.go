@@ -0,0 +1,164 @@
+package localdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value  string
+		expect LocalDate
+	}{
+		"Extended calendar date": {
+			value:  "2024-02-29",
+			expect: New(2024, time.February, 29),
+		},
+		"Basic calendar date": {
+			value:  "20240229",
+			expect: New(2024, time.February, 29),
+		},
+		"Extended ordinal date": {
+			value:  "2024-060",
+			expect: New(2024, time.February, 29),
+		},
+		"Basic ordinal date": {
+			value:  "2023365",
+			expect: New(2023, time.December, 31),
+		},
+		"Extended week date": {
+			value:  "2024-W09-4",
+			expect: New(2024, time.February, 29),
+		},
+		"Basic week date": {
+			value:  "2024W094",
+			expect: New(2024, time.February, 29),
+		},
+		"Week date in previous calendar year": {
+			value:  "2020-W01-1",
+			expect: New(2019, time.December, 30),
+		},
+		"Week 53": {
+			value:  "2020-W53-7",
+			expect: New(2021, time.January, 3),
+		},
+		"Expanded positive year": {
+			value:  "+12024-01-01",
+			expect: New(12024, time.January, 1),
+		},
+		"Expanded negative year": {
+			value:  "-0001-12-31",
+			expect: New(-1, time.December, 31),
+		},
+		"Expanded year ordinal date": {
+			value:  "+2024-060",
+			expect: New(2024, time.February, 29),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil {
+				t.Fatalf("Parse: unexpected error %v", err)
+			}
+
+			if !got.Equal(test.expect) {
+				t.Errorf("Parse: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     string
+		wantRange bool
+	}{
+		"Empty":                       {value: ""},
+		"Too short":                   {value: "202"},
+		"Not a date":                  {value: "hello"},
+		"Slashes":                     {value: "2024/02/29"},
+		"Mixed basic and extended":    {value: "2024-0229"},
+		"Single digit month":          {value: "2024-2-29"},
+		"Expanded year in basic form": {value: "+120240101"},
+		"Expanded year too short":     {value: "+024-01-01"},
+		"Week without number":         {value: "2024-W-4"},
+		"Non existent day":            {value: "2023-02-29", wantRange: true},
+		"Month 13":                    {value: "2024-13-01", wantRange: true},
+		"Ordinal day 366 in non leap": {value: "2023-366", wantRange: true},
+		"Week 53 in 52 week year":     {value: "2024-W53-1", wantRange: true},
+		"Day of week 8":               {value: "2024-W09-8", wantRange: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse: expected *ParseError, got %v", err)
+			}
+
+			var rangeErr *RangeError
+			if got := errors.As(err, &rangeErr); got != test.wantRange {
+				t.Errorf("Parse: expected range error %v, got %v", test.wantRange, err)
+			}
+
+			if !test.wantRange && !errors.Is(err, ErrSyntax) {
+				t.Errorf("Parse: expected ErrSyntax, got %v", err)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld     LocalDate
+		expect string
+	}{
+		"Regular date": {
+			ld:     New(2024, time.February, 29),
+			expect: "2024-02-29",
+		},
+		"Padded year": {
+			ld:     New(33, time.April, 3),
+			expect: "0033-04-03",
+		},
+		"Expanded positive year": {
+			ld:     New(12024, time.January, 1),
+			expect: "+12024-01-01",
+		},
+		"Negative year": {
+			ld:     New(-1, time.December, 31),
+			expect: "-0001-12-31",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.ld.String()
+			if got != test.expect {
+				t.Errorf("String: expected %q, got %q", test.expect, got)
+			}
+
+			parsed, err := Parse(got)
+			if err != nil || !parsed.Equal(test.ld) {
+				t.Errorf("Parse(String()): expected %v, got %v, %v", test.ld, parsed, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package localdate
+
+import "iter"
+
+const (
+	stepDays stepUnit = iota
+	stepMonths
+)
+
+type (
+	// Step is the amount of time Range advances on every iteration, created with Days, Weeks, Months or Years.
+	// A negative Step iterates backwards.
+	Step struct {
+		unit stepUnit
+		n    int
+	}
+
+	stepUnit int
+)
+
+// Days returns a Step of n days.
+func Days(n int) Step {
+	return Step{unit: stepDays, n: n}
+}
+
+// Months returns a Step of n months. As with PlusMonths, the day is clamped to the end of the month.
+func Months(n int) Step {
+	return Step{unit: stepMonths, n: n}
+}
+
+// Weeks returns a Step of n weeks.
+func Weeks(n int) Step {
+	return Step{unit: stepDays, n: n * 7}
+}
+
+// Years returns a Step of n years. As with PlusYears, February 29th is clamped to February 28th in non leap years.
+func Years(n int) Step {
+	return Step{unit: stepMonths, n: n * 12}
+}
+
+// Range returns an iterator over the dates from start (inclusive) to endExclusive, advancing by step.
+// With a negative step the dates are iterated in reverse, from start down to endExclusive, e.g.
+// Range(end, start, Days(-1)) iterates the days from end to start + 1 day.
+// The n-th date is computed from start, not from the previous date, so with Months(1) starting on January 31st
+// the dates are Jan 31st, Feb 29th, Mar 31st, and so on.
+// Range panics if step is zero.
+func Range(start, endExclusive LocalDate, step Step) iter.Seq[LocalDate] {
+	if step.n == 0 {
+		panic("localdate: Range step must not be zero")
+	}
+
+	return func(yield func(LocalDate) bool) {
+		for i := 0; ; i++ {
+			ld := step.addTo(start, i)
+			if (step.n > 0 && !ld.Before(endExclusive)) || (step.n < 0 && !ld.After(endExclusive)) {
+				return
+			}
+
+			if !yield(ld) {
+				return
+			}
+		}
+	}
+}
+
+// DatesUntil returns an iterator over the days from the LocalDate (inclusive) to end (exclusive).
+// It yields nothing if end is not after the LocalDate.
+func (ld LocalDate) DatesUntil(end LocalDate) iter.Seq[LocalDate] {
+	return Range(ld, end, Days(1))
+}
+
+// addTo returns ld plus the step times i.
+func (s Step) addTo(ld LocalDate, i int) LocalDate {
+	if s.unit == stepMonths {
+		return ld.PlusMonths(s.n * i)
+	}
+
+	return ld.PlusDays(s.n * i)
+}
@@ -0,0 +1,115 @@
+package localdate
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRange(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		start, end LocalDate
+		step       Step
+		expect     []LocalDate
+	}{
+		"Days": {
+			start:  New(2024, time.February, 27),
+			end:    New(2024, time.March, 2),
+			step:   Days(1),
+			expect: []LocalDate{New(2024, time.February, 27), New(2024, time.February, 28), New(2024, time.February, 29), New(2024, time.March, 1)},
+		},
+		"Every other day": {
+			start:  New(2024, time.February, 27),
+			end:    New(2024, time.March, 2),
+			step:   Days(2),
+			expect: []LocalDate{New(2024, time.February, 27), New(2024, time.February, 29)},
+		},
+		"Weeks": {
+			start:  New(2024, time.January, 1),
+			end:    New(2024, time.January, 16),
+			step:   Weeks(1),
+			expect: []LocalDate{New(2024, time.January, 1), New(2024, time.January, 8), New(2024, time.January, 15)},
+		},
+		"Months are clamped from start": {
+			start:  New(2024, time.January, 31),
+			end:    New(2024, time.May, 1),
+			step:   Months(1),
+			expect: []LocalDate{New(2024, time.January, 31), New(2024, time.February, 29), New(2024, time.March, 31), New(2024, time.April, 30)},
+		},
+		"Years from leap day": {
+			start:  New(2024, time.February, 29),
+			end:    New(2028, time.March, 1),
+			step:   Years(2),
+			expect: []LocalDate{New(2024, time.February, 29), New(2026, time.February, 28), New(2028, time.February, 29)},
+		},
+		"Reverse days": {
+			start:  New(2024, time.March, 1),
+			end:    New(2024, time.February, 27),
+			step:   Days(-1),
+			expect: []LocalDate{New(2024, time.March, 1), New(2024, time.February, 29), New(2024, time.February, 28)},
+		},
+		"Reverse months": {
+			start:  New(2024, time.March, 31),
+			end:    New(2023, time.December, 31),
+			step:   Months(-1),
+			expect: []LocalDate{New(2024, time.March, 31), New(2024, time.February, 29), New(2024, time.January, 31)},
+		},
+		"Empty when end is not after start": {
+			start: New(2024, time.March, 1),
+			end:   New(2024, time.March, 1),
+			step:  Days(1),
+		},
+		"Empty when going the wrong way": {
+			start: New(2024, time.March, 1),
+			end:   New(2024, time.April, 1),
+			step:  Days(-1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := slices.Collect(Range(test.start, test.end, test.step))
+			if !slices.Equal(got, test.expect) {
+				t.Errorf("Range: expected %v, got %v", test.expect, got)
+			}
+		})
+	}
+}
+
+func TestRangeBreak(t *testing.T) {
+	t.Parallel()
+
+	var got []LocalDate
+	for ld := range New(2024, time.January, 1).DatesUntil(New(2025, time.January, 1)) {
+		if ld.Month() == time.January && ld.Day() == 4 {
+			break
+		}
+
+		got = append(got, ld)
+	}
+
+	expect := []LocalDate{New(2024, time.January, 1), New(2024, time.January, 2), New(2024, time.January, 3)}
+	if !slices.Equal(got, expect) {
+		t.Errorf("DatesUntil: expected %v, got %v", expect, got)
+	}
+
+	if count := len(slices.Collect(New(2024, time.January, 1).DatesUntil(New(2025, time.January, 1)))); count != 366 {
+		t.Errorf("DatesUntil: expected 366 dates, got %d", count)
+	}
+}
+
+func TestRangeZeroStepPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Range: expected panic with a zero step")
+		}
+	}()
+
+	Range(New(2024, time.January, 1), New(2024, time.February, 1), Days(0))
+}
@@ -0,0 +1,78 @@
+package localdate
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ sql.Scanner   = (*LocalDate)(nil)
+	_ driver.Valuer = LocalDate{}
+	_ sql.Scanner   = (*NullLocalDate)(nil)
+	_ driver.Valuer = NullLocalDate{}
+)
+
+// NullLocalDate represents a LocalDate that may be null, e.g. a nullable DATE column.
+// Same concept as sql.NullTime.
+type NullLocalDate struct {
+	LocalDate LocalDate
+	// Valid is true if LocalDate is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner so a DATE column can be scanned into a LocalDate.
+// It accepts time.Time, whose location is ignored, and []byte or string values in any of the formats supported
+// by Parse, optionally followed by a time part, e.g. 2024-02-29 00:00:00.
+// NULL values are rejected, use NullLocalDate for nullable columns.
+func (ld *LocalDate) Scan(src any) error {
+	return codec.Scan(src, "localdate", "LocalDate", func(t time.Time) { *ld = FromTime(t) }, ld.scanText)
+}
+
+// Value implements driver.Valuer, sending the LocalDate as an ISO-8601 string, e.g. 2024-02-29,
+// so no time-zone conversion can happen on the way to the database.
+func (ld LocalDate) Value() (driver.Value, error) {
+	return ld.String(), nil
+}
+
+func (ld *LocalDate) scanText(s string) error {
+	// Some drivers return DATE values with a time part, e.g. 2024-02-29 00:00:00.
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		s = s[:i]
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*ld = parsed
+
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting NULL and the same values as LocalDate.Scan.
+func (n *NullLocalDate) Scan(src any) error {
+	if src == nil {
+		n.LocalDate, n.Valid = LocalDate{}, false
+
+		return nil
+	}
+
+	err := n.LocalDate.Scan(src)
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements driver.Valuer, sending NULL if the NullLocalDate is not valid.
+func (n NullLocalDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // NULL is represented by a nil value.
+	}
+
+	return n.LocalDate.Value()
+}
@@ -0,0 +1,121 @@
+package localdate
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src     any
+		expect  LocalDate
+		wantErr bool
+	}{
+		"time.Time keeps the wall clock date": {
+			src:    time.Date(2024, time.February, 29, 23, 0, 0, 0, time.FixedZone("UTC-5", -5*60*60)),
+			expect: New(2024, time.February, 29),
+		},
+		"Bytes": {
+			src:    []byte("2024-02-29"),
+			expect: New(2024, time.February, 29),
+		},
+		"String": {
+			src:    "2024-02-29",
+			expect: New(2024, time.February, 29),
+		},
+		"String with midnight time": {
+			src:    "2024-02-29 00:00:00",
+			expect: New(2024, time.February, 29),
+		},
+		"String with ISO time": {
+			src:    "2024-02-29T00:00:00Z",
+			expect: New(2024, time.February, 29),
+		},
+		"NULL": {
+			src:     nil,
+			wantErr: true,
+		},
+		"Unsupported type": {
+			src:     int64(20240229),
+			wantErr: true,
+		},
+		"Invalid date": {
+			src:     "2023-02-29",
+			wantErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got LocalDate
+
+			err := got.Scan(test.src)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Scan: expected error, got %v", got)
+				}
+
+				return
+			}
+
+			if err != nil || got != test.expect {
+				t.Errorf("Scan: expected %v, got %v, %v", test.expect, got, err)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	got, err := New(2024, time.February, 29).Value()
+	if err != nil || got != "2024-02-29" {
+		t.Errorf("Value: expected %q, got %v, %v", "2024-02-29", got, err)
+	}
+}
+
+func TestNullLocalDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src       any
+		expect    NullLocalDate
+		wantValue driver.Value
+	}{
+		"NULL": {
+			src:       nil,
+			expect:    NullLocalDate{},
+			wantValue: nil,
+		},
+		"Date": {
+			src:       "2024-02-29",
+			expect:    NullLocalDate{LocalDate: New(2024, time.February, 29), Valid: true},
+			wantValue: "2024-02-29",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NullLocalDate{LocalDate: New(2000, time.January, 1), Valid: true}
+			if err := got.Scan(test.src); err != nil || got != test.expect {
+				t.Fatalf("Scan: expected %v, got %v, %v", test.expect, got, err)
+			}
+
+			if value, err := got.Value(); err != nil || value != test.wantValue {
+				t.Errorf("Value: expected %v, got %v, %v", test.wantValue, value, err)
+			}
+		})
+	}
+
+	var invalid NullLocalDate
+	if err := invalid.Scan("not a date"); err == nil || invalid.Valid {
+		t.Errorf("Scan: expected error and invalid value, got %v, %v", invalid, err)
+	}
+}
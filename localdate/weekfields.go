@@ -0,0 +1,154 @@
+package localdate
+
+import (
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/arith"
+)
+
+var (
+	// ISOWeekFields is the ISO-8601 definition of weeks: weeks start on Monday and the first week of the year
+	// is the one with at least 4 days, i.e. the one containing the first Thursday.
+	//nolint:gochecknoglobals // global to improve readability.
+	ISOWeekFields = WeekFields{firstDayOfWeek: time.Monday, minimalDays: 4}
+
+	// USWeekFields is the definition of weeks used in the United States: weeks start on Sunday and the first week
+	// of the year is the one containing January 1st.
+	//nolint:gochecknoglobals // global to improve readability.
+	USWeekFields = WeekFields{firstDayOfWeek: time.Sunday, minimalDays: 1}
+
+	// MiddleEastWeekFields is the definition of weeks used in most of the Middle East: weeks start on Saturday and
+	// the first week of the year is the one containing January 1st.
+	//nolint:gochecknoglobals // global to improve readability.
+	MiddleEastWeekFields = WeekFields{firstDayOfWeek: time.Saturday, minimalDays: 1}
+)
+
+// WeekFields defines how weeks are numbered: the day a week starts on, and the minimal number of days the first
+// week of a month or year must have.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/temporal/WeekFields.html.
+// The zero value is the same as USWeekFields.
+type WeekFields struct {
+	firstDayOfWeek time.Weekday
+	minimalDays    int
+}
+
+// NewWeekFields creates WeekFields from the first day of the week and the minimal number of days in the first week.
+// Returns a *RangeError if the first day of week is not a valid time.Weekday, or minimalDays is not in [1, 7].
+func NewWeekFields(firstDayOfWeek time.Weekday, minimalDays int) (WeekFields, error) {
+	if firstDayOfWeek < time.Sunday || firstDayOfWeek > time.Saturday {
+		return WeekFields{}, &RangeError{
+			Field: "first day of week", Value: int(firstDayOfWeek), Min: int(time.Sunday), Max: int(time.Saturday),
+		}
+	}
+
+	if minimalDays < 1 || minimalDays > 7 {
+		return WeekFields{}, &RangeError{Field: "minimal days in first week", Value: minimalDays, Min: 1, Max: 7}
+	}
+
+	return WeekFields{firstDayOfWeek: firstDayOfWeek, minimalDays: minimalDays}, nil
+}
+
+// Date returns the LocalDate of the given day of week, in the given week of the week-based year.
+// Returns a *RangeError if the week does not exist in the week-based year.
+func (wf WeekFields) Date(weekBasedYear, week int, dayOfWeek time.Weekday) (LocalDate, error) {
+	start := wf.firstWeekStart(toEpochDay(weekBasedYear, time.January, 1))
+	if maxWeek := wf.WeeksInWeekBasedYear(weekBasedYear); week < 1 || week > maxWeek {
+		return LocalDate{}, &RangeError{Field: "week", Value: week, Min: 1, Max: maxWeek}
+	}
+
+	return OfEpochDay(start + int64((week-1)*7+wf.dayOfWeek(dayOfWeek)-1)), nil
+}
+
+// DayOfWeek returns the localized day of week of the LocalDate, from 1 (FirstDayOfWeek) to 7.
+func (wf WeekFields) DayOfWeek(ld LocalDate) int {
+	return wf.dayOfWeek(ld.Weekday())
+}
+
+// FirstDayOfWeek returns the day weeks start on.
+func (wf WeekFields) FirstDayOfWeek() time.Weekday {
+	return wf.firstDayOfWeek
+}
+
+// MinimalDaysInFirstWeek returns the minimal number of days in the first week of a month or year.
+func (wf WeekFields) MinimalDaysInFirstWeek() int {
+	return max(wf.minimalDays, 1)
+}
+
+// WeekBasedYear returns the week-based year of the LocalDate, which differs from its year for days in the
+// first or last week of the year, e.g. with ISOWeekFields 2024-12-30 belongs to the week-based year 2025.
+func (wf WeekFields) WeekBasedYear(ld LocalDate) int {
+	year, _ := wf.weekBasedYearAndWeek(ld)
+
+	return year
+}
+
+// WeekOfMonth returns the week of the month of the LocalDate.
+// Days before the first week of the month are in week 0.
+func (wf WeekFields) WeekOfMonth(ld LocalDate) int {
+	epochDay := ld.ToEpochDay()
+
+	return weekNumber(epochDay, wf.firstWeekStart(toEpochDay(ld.Year(), ld.Month(), 1)))
+}
+
+// WeekOfWeekBasedYear returns the week of the week-based year of the LocalDate, from 1 to 53.
+func (wf WeekFields) WeekOfWeekBasedYear(ld LocalDate) int {
+	_, week := wf.weekBasedYearAndWeek(ld)
+
+	return week
+}
+
+// WeekOfYear returns the week of the year of the LocalDate.
+// Days before the first week of the year are in week 0.
+func (wf WeekFields) WeekOfYear(ld LocalDate) int {
+	epochDay := ld.ToEpochDay()
+
+	return weekNumber(epochDay, wf.firstWeekStart(toEpochDay(ld.Year(), time.January, 1)))
+}
+
+// WeeksInWeekBasedYear returns the number of weeks in the week-based year, 52 or 53.
+func (wf WeekFields) WeeksInWeekBasedYear(weekBasedYear int) int {
+	start := wf.firstWeekStart(toEpochDay(weekBasedYear, time.January, 1))
+	end := wf.firstWeekStart(toEpochDay(weekBasedYear+1, time.January, 1))
+
+	return int((end - start) / 7)
+}
+
+// dayOfWeek returns the localized day of week, from 1 (FirstDayOfWeek) to 7.
+func (wf WeekFields) dayOfWeek(weekday time.Weekday) int {
+	return (int(weekday)-int(wf.firstDayOfWeek)+7)%7 + 1
+}
+
+// firstWeekStart returns the epoch day the first week of the month or year starting on periodStart begins on.
+// It's the start of the week containing periodStart if that week has at least the minimal days, or the next one.
+func (wf WeekFields) firstWeekStart(periodStart int64) int64 {
+	dow := wf.dayOfWeek(weekdayOf(periodStart))
+	weekStart := periodStart - int64(dow-1)
+
+	if daysInPeriod := 8 - dow; daysInPeriod < wf.MinimalDaysInFirstWeek() {
+		return weekStart + 7
+	}
+
+	return weekStart
+}
+
+func (wf WeekFields) weekBasedYearAndWeek(ld LocalDate) (int, int) {
+	epochDay := ld.ToEpochDay()
+	year := ld.Year()
+
+	start := wf.firstWeekStart(toEpochDay(year, time.January, 1))
+	switch {
+	case epochDay < start:
+		year--
+		start = wf.firstWeekStart(toEpochDay(year, time.January, 1))
+	case epochDay >= wf.firstWeekStart(toEpochDay(year+1, time.January, 1)):
+		year++
+		start = wf.firstWeekStart(toEpochDay(year, time.January, 1))
+	}
+
+	return year, weekNumber(epochDay, start)
+}
+
+// weekNumber returns the 1-based number of the week epochDay is in, counting from firstWeekStart.
+func weekNumber(epochDay, firstWeekStart int64) int {
+	return int(arith.FloorDiv(epochDay-firstWeekStart, 7)) + 1
+}
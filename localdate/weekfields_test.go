@@ -0,0 +1,179 @@
+package localdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestISOWeekMatchesTime(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(1899, time.December, 25, 0, 0, 0, 0, time.UTC)
+	for days := range 365 * 250 {
+		tt := start.AddDate(0, 0, days)
+		wantYear, wantWeek := tt.ISOWeek()
+
+		ld := FromTime(tt)
+		if year, week := ld.ISOWeek(); year != wantYear || week != wantWeek {
+			t.Fatalf("ISOWeek of %v: expected %d-W%02d, got %d-W%02d", ld, wantYear, wantWeek, year, week)
+		}
+
+		date, err := ISOWeekFields.Date(wantYear, wantWeek, tt.Weekday())
+		if err != nil || date != ld {
+			t.Fatalf("Date(%d, %d, %v): expected %v, got %v, %v", wantYear, wantWeek, tt.Weekday(), ld, date, err)
+		}
+	}
+}
+
+func TestWeekFieldsAroundYearEnd(t *testing.T) {
+	t.Parallel()
+
+	type week struct {
+		year, week int
+	}
+
+	tests := map[string]struct {
+		wf     WeekFields
+		expect map[LocalDate]week
+	}{
+		"ISO": {
+			wf: ISOWeekFields,
+			expect: map[LocalDate]week{
+				New(2024, time.December, 29): {2024, 52},
+				New(2024, time.December, 30): {2025, 1},
+				New(2025, time.January, 3):   {2025, 1},
+				New(2020, time.December, 31): {2020, 53},
+				New(2021, time.January, 3):   {2020, 53},
+				New(2021, time.January, 4):   {2021, 1},
+			},
+		},
+		"US": {
+			wf: USWeekFields,
+			expect: map[LocalDate]week{
+				New(2024, time.December, 28): {2024, 52},
+				New(2024, time.December, 29): {2025, 1},
+				New(2025, time.January, 1):   {2025, 1},
+				New(2025, time.January, 4):   {2025, 1},
+				New(2025, time.January, 5):   {2025, 2},
+				New(2022, time.January, 1):   {2022, 1},
+			},
+		},
+		"Middle East": {
+			wf: MiddleEastWeekFields,
+			expect: map[LocalDate]week{
+				New(2024, time.December, 27): {2024, 52},
+				New(2024, time.December, 28): {2025, 1},
+				New(2025, time.January, 3):   {2025, 1},
+				New(2025, time.January, 4):   {2025, 2},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			for ld, expect := range test.expect {
+				got := week{test.wf.WeekBasedYear(ld), test.wf.WeekOfWeekBasedYear(ld)}
+				if got != expect {
+					t.Errorf("week of %v: expected %v, got %v", ld, expect, got)
+				}
+
+				date, err := test.wf.Date(expect.year, expect.week, ld.Weekday())
+				if err != nil || date != ld {
+					t.Errorf("Date(%v): expected %v, got %v, %v", expect, ld, date, err)
+				}
+			}
+		})
+	}
+}
+
+func TestWeekOfMonthAndYear(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		wf          WeekFields
+		ld          LocalDate
+		dayOfWeek   int
+		weekOfMonth int
+		weekOfYear  int
+	}{
+		"ISO, first days of the year before week 1": {
+			wf:          ISOWeekFields,
+			ld:          New(2021, time.January, 1),
+			dayOfWeek:   5,
+			weekOfMonth: 0,
+			weekOfYear:  0,
+		},
+		"ISO, end of year in week 53 of the calendar year": {
+			wf:          ISOWeekFields,
+			ld:          New(2024, time.December, 31),
+			dayOfWeek:   2,
+			weekOfMonth: 5,
+			weekOfYear:  53,
+		},
+		"US, January 1st is always week 1": {
+			wf:          USWeekFields,
+			ld:          New(2022, time.January, 1),
+			dayOfWeek:   7,
+			weekOfMonth: 1,
+			weekOfYear:  1,
+		},
+		"US, mid month": {
+			wf:          USWeekFields,
+			ld:          New(2024, time.February, 29),
+			dayOfWeek:   5,
+			weekOfMonth: 5,
+			weekOfYear:  9,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.wf.DayOfWeek(test.ld); got != test.dayOfWeek {
+				t.Errorf("DayOfWeek: expected %d, got %d", test.dayOfWeek, got)
+			}
+
+			if got := test.wf.WeekOfMonth(test.ld); got != test.weekOfMonth {
+				t.Errorf("WeekOfMonth: expected %d, got %d", test.weekOfMonth, got)
+			}
+
+			if got := test.wf.WeekOfYear(test.ld); got != test.weekOfYear {
+				t.Errorf("WeekOfYear: expected %d, got %d", test.weekOfYear, got)
+			}
+		})
+	}
+}
+
+func TestNewWeekFields(t *testing.T) {
+	t.Parallel()
+
+	wf, err := NewWeekFields(time.Monday, 4)
+	if err != nil || wf != ISOWeekFields {
+		t.Errorf("NewWeekFields: expected ISOWeekFields, got %v, %v", wf, err)
+	}
+
+	if wf.FirstDayOfWeek() != time.Monday || wf.MinimalDaysInFirstWeek() != 4 {
+		t.Errorf("NewWeekFields: expected Monday and 4 days, got %v and %d", wf.FirstDayOfWeek(), wf.MinimalDaysInFirstWeek())
+	}
+
+	var rangeErr *RangeError
+	if _, err = NewWeekFields(time.Monday, 0); !errors.As(err, &rangeErr) {
+		t.Errorf("NewWeekFields: expected *RangeError, got %v", err)
+	}
+
+	if _, err = NewWeekFields(7, 1); !errors.As(err, &rangeErr) {
+		t.Errorf("NewWeekFields: expected *RangeError, got %v", err)
+	}
+
+	if _, err = ISOWeekFields.Date(2024, 53, time.Monday); !errors.As(err, &rangeErr) {
+		t.Errorf("Date: expected *RangeError, got %v", err)
+	}
+
+	if got := ISOWeekFields.WeeksInWeekBasedYear(2020); got != 53 {
+		t.Errorf("WeeksInWeekBasedYear: expected 53, got %d", got)
+	}
+}
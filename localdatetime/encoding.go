@@ -0,0 +1,36 @@
+package localdatetime
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = LocalDateTime{}
+	_ encoding.TextUnmarshaler = (*LocalDateTime)(nil)
+	_ json.Marshaler           = LocalDateTime{}
+	_ json.Unmarshaler         = (*LocalDateTime)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the LocalDateTime as an ISO-8601 string, e.g. "2024-02-29T10:15:30".
+func (ldt LocalDateTime) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + ldt.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the LocalDateTime in the ISO-8601 extended format.
+func (ldt LocalDateTime) MarshalText() ([]byte, error) {
+	return []byte(ldt.String()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting any of the formats supported by Parse.
+// A JSON null leaves the LocalDateTime unchanged.
+func (ldt *LocalDateTime) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "localdatetime: LocalDateTime", ldt.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any of the formats supported by Parse.
+func (ldt *LocalDateTime) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(ldt, data, Parse)
+}
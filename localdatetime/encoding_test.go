@@ -0,0 +1,74 @@
+package localdatetime
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type appointment struct {
+	Start LocalDateTime  `json:"start"`
+	End   *LocalDateTime `json:"end"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	start := New(2024, time.February, 29, 10, 15, 0, 0)
+	end := New(2024, time.February, 29, 11, 0, 0, 0)
+
+	tests := map[string]struct {
+		value appointment
+		want  string
+	}{
+		"Null pointer": {
+			value: appointment{Start: start},
+			want:  `{"start":"2024-02-29T10:15:00","end":null}`,
+		},
+		"Both date-times": {
+			value: appointment{Start: start, End: &end},
+			want:  `{"start":"2024-02-29T10:15:00","end":"2024-02-29T11:00:00"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(test.value)
+			if err != nil || string(data) != test.want {
+				t.Fatalf("json.Marshal = %s, %v, want %s", data, err, test.want)
+			}
+
+			var got appointment
+			if err = json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal unexpected error %v", err)
+			}
+
+			if got.Start != test.value.Start || (got.End == nil) != (test.value.End == nil) ||
+				(got.End != nil && *got.End != *test.value.End) {
+				t.Errorf("json.Unmarshal = %+v, want %+v", got, test.value)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"start":1}`, `{"start":"2024-02-30T10:00"}`} {
+		var got appointment
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, want error", data, got)
+		}
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	t.Parallel()
+
+	got := New(2024, time.February, 29, 10, 15, 0, 0)
+	if err := got.UnmarshalJSON([]byte("null")); err != nil || got != New(2024, time.February, 29, 10, 15, 0, 0) {
+		t.Errorf("UnmarshalJSON(null) = %v, %v, want unchanged", got, err)
+	}
+}
@@ -3,45 +3,82 @@
 package localdatetime
 
 import (
+	"cmp"
+	"fmt"
 	"time"
 
+	"github.com/manuelarte/gotimeplus/clock"
+	"github.com/manuelarte/gotimeplus/internal/arith"
 	"github.com/manuelarte/gotimeplus/localdate"
 	"github.com/manuelarte/gotimeplus/localtime"
 )
 
-var _ LocalDateTime = new(localDateTime)
-
-type (
-	LocalDateTime interface {
-		// After reports whether the LocalDateTime is after the given other LocalDateTime.
-		After(other LocalDateTime) bool
-		// Before reports whether the LocalDateTime is before the given other LocalDateTime.
-		Before(other LocalDateTime) bool
-		// Equal reports whether the LocalDateTime is equal to the given other LocalDateTime.
-		Equal(other LocalDateTime) bool
-		// ToTime converts the LocalDateTime to a time.Time in the provided location.
-		ToTime(loc *time.Location) time.Time
-	}
+var _ fmt.Stringer = LocalDateTime{}
 
-	localDateTime struct {
-		ld localdate.LocalDate
-		lt localtime.LocalTime
-	}
-)
+// LocalDateTime is a date-time without a time-zone, e.g. 2007-12-03T10:15:30.
+// It is a comparable value, so it can be used with == and as a map key.
+// The zero value is midnight of January 1, year 1, the same instant as the zero time.Time in UTC.
+type LocalDateTime struct {
+	ld localdate.LocalDate
+	lt localtime.LocalTime
+}
 
+// New LocalDateTime from year, month, day, hours, minutes, seconds and nanoseconds.
+// The values are not validated, use Of to reject them, or Normalize to roll them over.
 func New(year int, month time.Month, day, hour, minutes, sec, nsec int) LocalDateTime {
 	return NewFrom(localdate.New(year, month, day), localtime.New(hour, minutes, sec, nsec))
 }
 
+// Of LocalDateTime from year, month, day, hours, minutes, seconds and nanoseconds.
+// Returns a *localdate.RangeError or a *localtime.RangeError naming the first field out of range.
+func Of(year int, month time.Month, day, hour, minutes, sec, nsec int) (LocalDateTime, error) {
+	ld, err := localdate.Of(year, month, day)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+
+	lt, err := localtime.Of(hour, minutes, sec, nsec)
+	if err != nil {
+		return LocalDateTime{}, err
+	}
+
+	return NewFrom(ld, lt), nil
+}
+
+// Normalize LocalDateTime from year, month, day, hours, minutes, seconds and nanoseconds, rolling out of range
+// values over the same way time.Date does, e.g. 2024-02-29 24:00 becomes 2024-03-01 00:00.
+// Unlike time.Date, years outside the range of time.Time are supported.
+func Normalize(year int, month time.Month, day, hour, minutes, sec, nsec int) LocalDateTime {
+	const nanosPerDay = int64(24 * time.Hour)
+
+	// Carry the whole days of every field first, so the sum of the rest cannot overflow.
+	days := arith.FloorDiv(int64(hour), 24) + arith.FloorDiv(int64(minutes), 24*60) +
+		arith.FloorDiv(int64(sec), 24*60*60) + arith.FloorDiv(int64(nsec), nanosPerDay)
+	nanos := arith.FloorMod(int64(hour), 24)*int64(time.Hour) + arith.FloorMod(int64(minutes), 24*60)*int64(time.Minute) +
+		arith.FloorMod(int64(sec), 24*60*60)*int64(time.Second) + arith.FloorMod(int64(nsec), nanosPerDay)
+	days += nanos / nanosPerDay
+	nanos %= nanosPerDay
+
+	return NewFrom(
+		localdate.Normalize(year, month, day+int(days)),
+		localtime.New(
+			int(nanos/int64(time.Hour)),
+			int(nanos%int64(time.Hour)/int64(time.Minute)),
+			int(nanos%int64(time.Minute)/int64(time.Second)),
+			int(nanos%int64(time.Second)),
+		),
+	)
+}
+
 // NewFrom LocalDateTime from localDate and localTime.
 func NewFrom(ld localdate.LocalDate, lt localtime.LocalTime) LocalDateTime {
-	return &localDateTime{
+	return LocalDateTime{
 		ld: ld,
 		lt: lt,
 	}
 }
 
-// FromTime converts time.Time to LocalDate.
+// FromTime converts time.Time to LocalDateTime.
 func FromTime(t time.Time) LocalDateTime {
 	return NewFrom(
 		localdate.FromTime(t),
@@ -49,19 +86,53 @@ func FromTime(t time.Time) LocalDateTime {
 	)
 }
 
-func (ldt localDateTime) After(other LocalDateTime) bool {
-	return ldt.ToTime(time.UTC).After(other.ToTime(time.UTC))
+// Now returns the current date and time of the clock in the location.
+func Now(c clock.Clock, loc *time.Location) LocalDateTime {
+	return FromTime(c.Now().In(loc))
+}
+
+// After reports whether the LocalDateTime is after the given other LocalDateTime.
+func (ldt LocalDateTime) After(other LocalDateTime) bool {
+	return ldt.ld.After(other.ld) || (ldt.ld == other.ld && ldt.lt.After(other.lt))
+}
+
+// Before reports whether the LocalDateTime is before the given other LocalDateTime.
+func (ldt LocalDateTime) Before(other LocalDateTime) bool {
+	return ldt.ld.Before(other.ld) || (ldt.ld == other.ld && ldt.lt.Before(other.lt))
+}
+
+// Compare returns -1 if the LocalDateTime is before other, 0 if they are equal, and +1 if it's after.
+func (ldt LocalDateTime) Compare(other LocalDateTime) int {
+	return cmp.Or(ldt.ld.Compare(other.ld), ldt.lt.Compare(other.lt))
+}
+
+// Date returns the LocalDate part of the LocalDateTime.
+func (ldt LocalDateTime) Date() localdate.LocalDate {
+	return ldt.ld
+}
+
+// Equal reports whether the LocalDateTime is equal to the given other LocalDateTime.
+// It's the same as using ==.
+func (ldt LocalDateTime) Equal(other LocalDateTime) bool {
+	return ldt == other
+}
+
+// IsZero reports whether the LocalDateTime is the zero value, midnight of January 1, year 1.
+func (ldt LocalDateTime) IsZero() bool {
+	return ldt == LocalDateTime{}
 }
 
-func (ldt localDateTime) Before(other LocalDateTime) bool {
-	return ldt.ToTime(time.UTC).Before(other.ToTime(time.UTC))
+// String returns the LocalDateTime in the ISO-8601 extended format, e.g. 2024-02-29T10:15:30.
+func (ldt LocalDateTime) String() string {
+	return ldt.ld.String() + "T" + ldt.lt.String()
 }
 
-func (ldt localDateTime) Equal(other LocalDateTime) bool {
-	return ldt.ToTime(time.UTC).Equal(other.ToTime(time.UTC))
+// Time returns the LocalTime part of the LocalDateTime.
+func (ldt LocalDateTime) Time() localtime.LocalTime {
+	return ldt.lt
 }
 
-func (ldt localDateTime) ToTime(loc *time.Location) time.Time {
-	return time.Date(ldt.ld.Year(), ldt.ld.Month(), ldt.ld.Day(),
-		ldt.lt.Hour(), ldt.lt.Min(), ldt.lt.Sec(), ldt.lt.Nanosecond(), loc)
+// ToTime converts the LocalDateTime to a time.Time in the provided location.
+func (ldt LocalDateTime) ToTime(loc *time.Location) time.Time {
+	return ldt.lt.ToTime(ldt.ld, loc)
 }
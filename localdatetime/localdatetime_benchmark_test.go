@@ -0,0 +1,42 @@
+package localdatetime
+
+import (
+	"testing"
+	"time"
+)
+
+//nolint:gochecknoglobals // sinks to prevent the compiler from optimising the benchmarks away.
+var (
+	sinkBool          bool
+	sinkLocalDateTime LocalDateTime
+)
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := range b.N {
+		sinkLocalDateTime = New(2024, time.February, 29, i%24, 0, 0, 0)
+	}
+}
+
+// BenchmarkBefore compares the field-wise comparison against the former implementation,
+// that converted both date-times to time.Time.
+func BenchmarkBefore(b *testing.B) {
+	x, y := New(2024, time.February, 29, 10, 0, 0, 0), New(2024, time.February, 29, 11, 0, 0, 0)
+
+	b.Run("field-wise", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.Before(y)
+		}
+	})
+
+	b.Run("via time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.ToTime(time.UTC).Before(y.ToTime(time.UTC))
+		}
+	})
+}
@@ -1,9 +1,11 @@
 package localdatetime
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/manuelarte/gotimeplus/clock"
 	"github.com/manuelarte/gotimeplus/localdate"
 	"github.com/manuelarte/gotimeplus/localtime"
 )
@@ -123,6 +125,45 @@ func TestAfter(t *testing.T) {
 	}
 }
 
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b   LocalDateTime
+		expect int
+	}{
+		"A is after B by date": {
+			a:      New(2024, time.July, 6, 0, 0, 0, 0),
+			b:      New(2024, time.July, 5, 23, 59, 59, 0),
+			expect: 1,
+		},
+		"A is same as B": {
+			a:      New(2024, time.July, 5, 10, 15, 30, 0),
+			b:      New(2024, time.July, 5, 10, 15, 30, 0),
+			expect: 0,
+		},
+		"A is before B by time": {
+			a:      New(2024, time.July, 5, 10, 15, 30, 0),
+			b:      New(2024, time.July, 5, 10, 15, 30, 1),
+			expect: -1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.expect {
+				t.Errorf("Compare: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.b.Compare(test.a); got != -test.expect {
+				t.Errorf("Compare reversed: expected %v, got %v", -test.expect, got)
+			}
+		})
+	}
+}
+
 func TestEqual(t *testing.T) {
 	t.Parallel()
 
@@ -163,3 +204,172 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year                     int
+		month                    time.Month
+		day                      int
+		hour, minutes, sec, nsec int
+		wantDateErr              *localdate.RangeError
+		wantTimeErr              *localtime.RangeError
+	}{
+		"Valid date time": {
+			year: 2024, month: time.February, day: 29, hour: 23, minutes: 59, sec: 59,
+		},
+		"Invalid day": {
+			year: 2024, month: time.February, day: 30,
+			wantDateErr: &localdate.RangeError{Field: "day", Value: 30, Min: 1, Max: 29},
+		},
+		"Invalid hour": {
+			year: 2024, month: time.February, day: 29, hour: 25,
+			wantTimeErr: &localtime.RangeError{Field: "hour", Value: 25, Min: 0, Max: 23},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.year, test.month, test.day, test.hour, test.minutes, test.sec, test.nsec)
+
+			var dateErr *localdate.RangeError
+			if test.wantDateErr != nil && (!errors.As(err, &dateErr) || *dateErr != *test.wantDateErr) {
+				t.Fatalf("Of error = %v, want %v", err, test.wantDateErr)
+			}
+
+			var timeErr *localtime.RangeError
+			if test.wantTimeErr != nil && (!errors.As(err, &timeErr) || *timeErr != *test.wantTimeErr) {
+				t.Fatalf("Of error = %v, want %v", err, test.wantTimeErr)
+			}
+
+			if test.wantDateErr != nil || test.wantTimeErr != nil {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Of unexpected error %v", err)
+			}
+
+			want := New(test.year, test.month, test.day, test.hour, test.minutes, test.sec, test.nsec)
+			if !got.Equal(want) {
+				t.Errorf("Of = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year                     int
+		month                    time.Month
+		day                      int
+		hour, minutes, sec, nsec int
+		want                     LocalDateTime
+	}{
+		"Valid date time is kept": {
+			year: 2024, month: time.July, day: 5, hour: 10,
+			want: New(2024, time.July, 5, 10, 0, 0, 0),
+		},
+		"Hour 24 rolls over to the next day": {
+			year: 2024, month: time.February, day: 29, hour: 24,
+			want: New(2024, time.March, 1, 0, 0, 0, 0),
+		},
+		"February 30th": {
+			year: 2023, month: time.February, day: 30, hour: 12,
+			want: New(2023, time.March, 2, 12, 0, 0, 0),
+		},
+		"Year outside the range of time.Time": {
+			year: 300_000_000_000, month: time.February, day: 30,
+			want: New(300_000_000_000, time.March, 1, 0, 0, 0, 0),
+		},
+		"Huge hours carry the days": {
+			year: 2024, month: time.January, day: 1, hour: 1 << 62,
+			want: New(526_098_644_330_493, time.November, 19, 16, 0, 0, 0),
+		},
+		"Negative nanoseconds borrow a day": {
+			year: 2024, month: time.March, day: 1, nsec: -1,
+			want: New(2024, time.February, 29, 23, 59, 59, 999_999_999),
+		},
+		"Every field out of range": {
+			year: 2024, month: 13, day: 32, hour: 25, minutes: 61, sec: 61, nsec: 1_000_000_001,
+			want: New(2025, time.February, 2, 2, 2, 2, 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Normalize(test.year, test.month, test.day, test.hour, test.minutes, test.sec, test.nsec)
+			if got != test.want {
+				t.Errorf("Normalize = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var zero LocalDateTime
+	if !zero.IsZero() {
+		t.Errorf("IsZero = false, want true")
+	}
+
+	if !zero.ToTime(time.UTC).Equal(time.Time{}) {
+		t.Errorf("ToTime = %v, want the zero time.Time", zero.ToTime(time.UTC))
+	}
+
+	if !zero.Equal(FromTime(time.Time{})) {
+		t.Errorf("FromTime(time.Time{}) = %v, want zero value", FromTime(time.Time{}))
+	}
+}
+
+func TestDateAndTime(t *testing.T) {
+	t.Parallel()
+
+	ldt := New(2024, time.February, 29, 10, 15, 30, 0)
+
+	if got, want := ldt.Date(), localdate.New(2024, time.February, 29); got != want {
+		t.Errorf("Date = %v, want %v", got, want)
+	}
+
+	if got, want := ldt.Time(), localtime.New(10, 15, 30, 0); got != want {
+		t.Errorf("Time = %v, want %v", got, want)
+	}
+
+	appointments := map[LocalDateTime]string{ldt: "dentist"}
+	if got := appointments[NewFrom(ldt.Date(), ldt.Time())]; got != "dentist" {
+		t.Errorf("map lookup = %q, want %q", got, "dentist")
+	}
+}
+
+func TestNow(t *testing.T) {
+	t.Parallel()
+
+	c := clock.Fixed(time.Date(2024, time.March, 5, 23, 30, 15, 0, time.UTC))
+
+	tests := map[string]struct {
+		loc  *time.Location
+		want LocalDateTime
+	}{
+		"UTC":           {loc: time.UTC, want: New(2024, time.March, 5, 23, 30, 15, 0)},
+		"Next day east": {loc: time.FixedZone("UTC+9", 9*3600), want: New(2024, time.March, 6, 8, 30, 15, 0)},
+		"Same day west": {loc: time.FixedZone("UTC-5", -5*3600), want: New(2024, time.March, 5, 18, 30, 15, 0)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Now(c, test.loc); got != test.want {
+				t.Errorf("Now = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
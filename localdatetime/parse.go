@@ -0,0 +1,66 @@
+package localdatetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+// ErrSyntax indicates that a value is not an ISO-8601 date and time separated by 'T'.
+var ErrSyntax = errors.New("invalid ISO-8601 date-time syntax")
+
+// ParseError describes a problem parsing a LocalDateTime.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure: ErrSyntax, or the Err of the *localdate.ParseError
+	// or *localtime.ParseError describing the problem in the date or time part.
+	Err error
+}
+
+// Parse a LocalDateTime from an ISO-8601 date and time separated by 'T', e.g. 2024-02-29T10:15:30.
+// The date accepts the formats supported by localdate.Parse, and the time the ones supported by localtime.Parse.
+// Returns a *ParseError if the value can't be parsed or the date-time does not exist.
+func Parse(s string) (LocalDateTime, error) {
+	return parseWithSeparator(s, 'T')
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("localdatetime: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parseWithSeparator(s string, sep byte) (LocalDateTime, error) {
+	datePart, timePart, found := strings.Cut(s, string(sep))
+	if !found {
+		return LocalDateTime{}, &ParseError{Value: s, Err: ErrSyntax}
+	}
+
+	ld, err := localdate.Parse(datePart)
+	if err != nil {
+		var dateErr *localdate.ParseError
+		if errors.As(err, &dateErr) {
+			err = dateErr.Err
+		}
+
+		return LocalDateTime{}, &ParseError{Value: s, Err: err}
+	}
+
+	lt, err := localtime.Parse(timePart)
+	if err != nil {
+		var timeErr *localtime.ParseError
+		if errors.As(err, &timeErr) {
+			err = timeErr.Err
+		}
+
+		return LocalDateTime{}, &ParseError{Value: s, Err: err}
+	}
+
+	return NewFrom(ld, lt), nil
+}
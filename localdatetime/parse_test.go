@@ -0,0 +1,98 @@
+package localdatetime
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  LocalDateTime
+	}{
+		"Extended": {
+			value: "2024-02-29T10:15:30",
+			want:  New(2024, time.February, 29, 10, 15, 30, 0),
+		},
+		"Basic": {
+			value: "20240229T101530.5",
+			want:  New(2024, time.February, 29, 10, 15, 30, 500_000_000),
+		},
+		"Week date": {
+			value: "2024-W09-4T10:15",
+			want:  New(2024, time.February, 29, 10, 15, 0, 0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil {
+				t.Fatalf("Parse unexpected error %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Parse = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  error
+	}{
+		"No separator":   {value: "2024-02-29 10:15:30", want: ErrSyntax},
+		"Invalid date":   {value: "2024/02/29T10:15:30", want: localdate.ErrSyntax},
+		"Invalid time":   {value: "2024-02-29T10h15", want: localtime.ErrSyntax},
+		"Date too short": {value: "T10:15", want: localdate.ErrSyntax},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse error = %v, want *ParseError", err)
+			}
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Parse error = %v, want %v", err, test.want)
+			}
+		})
+	}
+
+	_, err := Parse("2023-02-29T10:15")
+
+	var rangeErr *localdate.RangeError
+	if !errors.As(err, &rangeErr) {
+		t.Errorf("Parse error = %v, want *localdate.RangeError", err)
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	ldt := New(2024, time.February, 29, 10, 15, 30, 1_000)
+	if got, want := ldt.String(), "2024-02-29T10:15:30.000001"; got != want {
+		t.Errorf("String = %q, want %q", got, want)
+	}
+
+	if got := (LocalDateTime{}).String(); got != "0001-01-01T00:00:00" {
+		t.Errorf("String = %q, want %q", got, "0001-01-01T00:00:00")
+	}
+}
@@ -0,0 +1,78 @@
+package localdatetime
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ sql.Scanner   = (*LocalDateTime)(nil)
+	_ driver.Valuer = LocalDateTime{}
+	_ sql.Scanner   = (*NullLocalDateTime)(nil)
+	_ driver.Valuer = NullLocalDateTime{}
+)
+
+// NullLocalDateTime represents a LocalDateTime that may be null, e.g. a nullable TIMESTAMP WITHOUT TIME ZONE column.
+// Same concept as sql.NullTime.
+type NullLocalDateTime struct {
+	LocalDateTime LocalDateTime
+	// Valid is true if LocalDateTime is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner so a TIMESTAMP WITHOUT TIME ZONE (or DATETIME) column can be scanned into a
+// LocalDateTime. It accepts time.Time, whose location is ignored, and []byte or string values in the format
+// supported by Parse, with either 'T' or a space as separator, e.g. 2024-02-29 10:15:30.
+// NULL values are rejected, use NullLocalDateTime for nullable columns.
+func (ldt *LocalDateTime) Scan(src any) error {
+	return codec.Scan(src, "localdatetime", "LocalDateTime", func(t time.Time) { *ldt = FromTime(t) }, ldt.scanText)
+}
+
+// Value implements driver.Valuer, sending the LocalDateTime as an ISO-8601 string, e.g. 2024-02-29T10:15:30,
+// so no time-zone conversion can happen on the way to the database.
+func (ldt LocalDateTime) Value() (driver.Value, error) {
+	return ldt.String(), nil
+}
+
+func (ldt *LocalDateTime) scanText(s string) error {
+	sep := byte('T')
+	if strings.IndexByte(s, ' ') >= 0 {
+		sep = ' '
+	}
+
+	parsed, err := parseWithSeparator(s, sep)
+	if err != nil {
+		return err
+	}
+
+	*ldt = parsed
+
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting NULL and the same values as LocalDateTime.Scan.
+func (n *NullLocalDateTime) Scan(src any) error {
+	if src == nil {
+		n.LocalDateTime, n.Valid = LocalDateTime{}, false
+
+		return nil
+	}
+
+	err := n.LocalDateTime.Scan(src)
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements driver.Valuer, sending NULL if the NullLocalDateTime is not valid.
+func (n NullLocalDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // NULL is represented by a nil value.
+	}
+
+	return n.LocalDateTime.Value()
+}
@@ -0,0 +1,90 @@
+package localdatetime
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src     any
+		want    LocalDateTime
+		wantErr bool
+	}{
+		"time.Time keeps the wall clock": {
+			src:  time.Date(2024, time.February, 29, 23, 30, 0, 0, time.FixedZone("UTC-5", -5*60*60)),
+			want: New(2024, time.February, 29, 23, 30, 0, 0),
+		},
+		"Bytes with space separator": {
+			src:  []byte("2024-02-29 10:15:30.5"),
+			want: New(2024, time.February, 29, 10, 15, 30, 500_000_000),
+		},
+		"String with T separator": {
+			src:  "2024-02-29T10:15:30",
+			want: New(2024, time.February, 29, 10, 15, 30, 0),
+		},
+		"NULL":             {src: nil, wantErr: true},
+		"Unsupported type": {src: int64(0), wantErr: true},
+		"Date only":        {src: "2024-02-29", wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got LocalDateTime
+
+			err := got.Scan(test.src)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Scan = %v, want error", got)
+				}
+
+				return
+			}
+
+			if err != nil || got != test.want {
+				t.Errorf("Scan = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestNullLocalDateTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src       any
+		want      NullLocalDateTime
+		wantValue driver.Value
+	}{
+		"NULL": {
+			src:       nil,
+			want:      NullLocalDateTime{},
+			wantValue: nil,
+		},
+		"Date-time": {
+			src:       "2024-02-29 10:15:30",
+			want:      NullLocalDateTime{LocalDateTime: New(2024, time.February, 29, 10, 15, 30, 0), Valid: true},
+			wantValue: "2024-02-29T10:15:30",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NullLocalDateTime{LocalDateTime: New(2000, time.January, 1, 0, 0, 0, 0), Valid: true}
+			if err := got.Scan(test.src); err != nil || got != test.want {
+				t.Fatalf("Scan = %v, %v, want %v", got, err, test.want)
+			}
+
+			if value, err := got.Value(); err != nil || value != test.wantValue {
+				t.Errorf("Value = %v, %v, want %v", value, err, test.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package localtime
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = LocalTime{}
+	_ encoding.TextUnmarshaler = (*LocalTime)(nil)
+	_ json.Marshaler           = LocalTime{}
+	_ json.Unmarshaler         = (*LocalTime)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the LocalTime as an ISO-8601 string, e.g. "10:15:30".
+func (lt LocalTime) MarshalJSON() ([]byte, error) {
+	return codec.MarshalJSON(len("hh:mm:ss.fffffffff"), lt.appendFormat), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the LocalTime in the ISO-8601 extended format.
+func (lt LocalTime) MarshalText() ([]byte, error) {
+	return lt.appendFormat(make([]byte, 0, len("hh:mm:ss.fffffffff"))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting any of the formats supported by Parse.
+// A JSON null leaves the LocalTime unchanged.
+func (lt *LocalTime) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "localtime: LocalTime", lt.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting any of the formats supported by Parse.
+func (lt *LocalTime) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(lt, data, Parse)
+}
@@ -0,0 +1,78 @@
+package localtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type shop struct {
+	Opens  LocalTime  `json:"opens"`
+	Closes *LocalTime `json:"closes"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value shop
+		want  string
+	}{
+		"Null pointer": {
+			value: shop{Opens: New(9, 0, 0, 0)},
+			want:  `{"opens":"09:00:00","closes":null}`,
+		},
+		"Both times": {
+			value: shop{Opens: New(9, 0, 0, 0), Closes: ptr(New(17, 30, 0, 0))},
+			want:  `{"opens":"09:00:00","closes":"17:30:00"}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			data, err := json.Marshal(test.value)
+			if err != nil || string(data) != test.want {
+				t.Fatalf("json.Marshal = %s, %v, want %s", data, err, test.want)
+			}
+
+			var got shop
+			if err = json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal unexpected error %v", err)
+			}
+
+			if got.Opens != test.value.Opens || (got.Closes == nil) != (test.value.Closes == nil) ||
+				(got.Closes != nil && *got.Closes != *test.value.Closes) {
+				t.Errorf("json.Unmarshal = %+v, want %+v", got, test.value)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"opens":930}`, `{"opens":"25:00"}`} {
+		var got shop
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, want error", data, got)
+		}
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	t.Parallel()
+
+	var got LocalTime
+	if err := got.UnmarshalText([]byte("10:15")); err != nil || got != New(10, 15, 0, 0) {
+		t.Errorf("UnmarshalText = %v, %v, want 10:15:00", got, err)
+	}
+
+	if text, err := got.MarshalText(); err != nil || string(text) != "10:15:00" {
+		t.Errorf("MarshalText = %q, %v, want %q", text, err, "10:15:00")
+	}
+}
+
+func ptr[T any](t T) *T {
+	return &t
+}
@@ -3,37 +3,41 @@
 package localtime
 
 import (
+	"cmp"
+	"fmt"
 	"time"
 
+	"github.com/manuelarte/gotimeplus/clock"
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/internal/codec"
 	"github.com/manuelarte/gotimeplus/localdate"
 )
 
-var _ LocalTime = new(localTime)
+var _ fmt.Stringer = LocalTime{}
 
 type (
-	LocalTime interface {
-		// After reports whether the LocalDate is after the given other LocalDate.
-		After(other LocalTime) bool
-		// Before reports whether the LocalDate is before the given other LocalDate.
-		Before(other LocalTime) bool
-		// Equal reports whether the LocalDate is equal to the given other LocalDate.
-		Equal(other LocalTime) bool
-		Hour() int
-		Min() int
-		Nanosecond() int
-		Sec() int
-		// ToTime converts the LocalTime to a time.Time provided with a LocalDate and a location.
-		ToTime(localDate localdate.LocalDate, loc *time.Location) time.Time
+	// LocalTime is a time of the day without a date or time-zone, e.g. 10:15:30.
+	// It is a comparable value, so it can be used with == and as a map key.
+	// The zero value is midnight, 00:00.
+	LocalTime struct {
+		hour, min, sec, nsec int
 	}
 
-	localTime struct {
-		hour, min, sec, nsec int
+	// RangeError is returned by Of when a field of the time is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field: "hour", "minute", "second" or "nanosecond".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
 	}
 )
 
 // New LocalTime from hours, minutes, seconds and nanoseconds.
+// The values are not validated, use Of to reject times like 25:61, or Normalize to roll them over.
 func New(hour, minutes, sec, nsec int) LocalTime {
-	return &localTime{
+	return LocalTime{
 		hour: hour,
 		min:  minutes,
 		sec:  sec,
@@ -41,40 +45,133 @@ func New(hour, minutes, sec, nsec int) LocalTime {
 	}
 }
 
-func (lt localTime) After(other LocalTime) bool {
-	ld := localdate.New(2009, time.November, 10)
+// Of LocalTime from hours, minutes, seconds and nanoseconds.
+// Returns a *RangeError if any of the fields is outside its range, e.g. hour not in [0, 23].
+func Of(hour, minutes, sec, nsec int) (LocalTime, error) {
+	fields := []struct {
+		name     string
+		value    int
+		min, max int
+	}{
+		{"hour", hour, 0, 23},
+		{"minute", minutes, 0, 59},
+		{"second", sec, 0, 59},
+		{"nanosecond", nsec, 0, 999_999_999},
+	}
+	for _, f := range fields {
+		if f.value < f.min || f.value > f.max {
+			return LocalTime{}, &RangeError{Field: f.name, Value: f.value, Min: f.min, Max: f.max}
+		}
+	}
+
+	return New(hour, minutes, sec, nsec), nil
+}
+
+// Normalize LocalTime from hours, minutes, seconds and nanoseconds, rolling out of range values over
+// the same way time.Date does, and wrapping around midnight, e.g. 25:61 becomes 02:01.
+func Normalize(hour, minutes, sec, nsec int) LocalTime {
+	const day = int64(24 * time.Hour)
 
-	return lt.ToTime(ld, time.UTC).After(other.ToTime(ld, time.UTC))
+	// Reduce every field modulo a day first, so the sum cannot overflow.
+	n := arith.FloorMod(int64(hour), 24)*int64(time.Hour) + arith.FloorMod(int64(minutes), 24*60)*int64(time.Minute) +
+		arith.FloorMod(int64(sec), 24*60*60)*int64(time.Second) + arith.FloorMod(int64(nsec), day)
+	d := time.Duration(n % day)
+
+	return New(int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second), int(d%time.Second))
 }
 
-func (lt localTime) Before(other LocalTime) bool {
-	ld := localdate.New(2009, time.November, 10)
+// Now returns the current time of the clock in the location.
+func Now(c clock.Clock, loc *time.Location) LocalTime {
+	t := c.Now().In(loc)
+
+	return New(t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+}
 
-	return lt.ToTime(ld, time.UTC).Before(other.ToTime(ld, time.UTC))
+// After reports whether the LocalTime is after the given other LocalTime.
+func (lt LocalTime) After(other LocalTime) bool {
+	return lt.Compare(other) > 0
 }
 
-func (lt localTime) Equal(other LocalTime) bool {
-	ld := localdate.New(2009, time.November, 10)
+// Before reports whether the LocalTime is before the given other LocalTime.
+func (lt LocalTime) Before(other LocalTime) bool {
+	return lt.Compare(other) < 0
+}
+
+// Compare returns -1 if the LocalTime is before other, 0 if they are equal, and +1 if it's after.
+func (lt LocalTime) Compare(other LocalTime) int {
+	switch {
+	case lt.hour != other.hour:
+		return cmp.Compare(lt.hour, other.hour)
+	case lt.min != other.min:
+		return cmp.Compare(lt.min, other.min)
+	case lt.sec != other.sec:
+		return cmp.Compare(lt.sec, other.sec)
+	default:
+		return cmp.Compare(lt.nsec, other.nsec)
+	}
+}
 
-	return lt.ToTime(ld, time.UTC).Equal(other.ToTime(ld, time.UTC))
+// Equal reports whether the LocalTime is equal to the given other LocalTime.
+// It's the same as using ==.
+func (lt LocalTime) Equal(other LocalTime) bool {
+	return lt == other
 }
 
-func (lt localTime) Hour() int {
+func (lt LocalTime) Hour() int {
 	return lt.hour
 }
 
-func (lt localTime) Min() int {
+// IsZero reports whether the LocalTime is the zero value, midnight.
+func (lt LocalTime) IsZero() bool {
+	return lt == LocalTime{}
+}
+
+func (lt LocalTime) Min() int {
 	return lt.min
 }
 
-func (lt localTime) Nanosecond() int {
+func (lt LocalTime) Nanosecond() int {
 	return lt.nsec
 }
 
-func (lt localTime) Sec() int {
+func (lt LocalTime) Sec() int {
 	return lt.sec
 }
 
-func (lt localTime) ToTime(ld localdate.LocalDate, loc *time.Location) time.Time {
-	return time.Date(ld.Year(), ld.Month(), ld.Day(), lt.Hour(), lt.Min(), lt.Sec(), lt.Nanosecond(), loc)
+// String returns the LocalTime in the ISO-8601 extended format hh:mm:ss, followed by the fraction of second
+// in groups of 3 digits if it's not zero, e.g. 10:15:30 or 10:15:30.500.
+func (lt LocalTime) String() string {
+	return string(lt.appendFormat(make([]byte, 0, len("hh:mm:ss.fffffffff"))))
+}
+
+// ToTime converts the LocalTime to a time.Time provided with a LocalDate and a location.
+func (lt LocalTime) ToTime(ld localdate.LocalDate, loc *time.Location) time.Time {
+	return time.Date(ld.Year(), ld.Month(), ld.Day(), lt.hour, lt.min, lt.sec, lt.nsec, loc)
+}
+
+func (lt LocalTime) appendFormat(b []byte) []byte {
+	b = codec.AppendInt(b, lt.hour, 2)
+	b = append(b, ':')
+	b = codec.AppendInt(b, lt.min, 2)
+	b = append(b, ':')
+	b = codec.AppendInt(b, lt.sec, 2)
+
+	if lt.nsec == 0 {
+		return b
+	}
+
+	b = append(b, '.')
+
+	switch {
+	case lt.nsec%1_000_000 == 0:
+		return codec.AppendInt(b, lt.nsec/1_000_000, 3)
+	case lt.nsec%1_000 == 0:
+		return codec.AppendInt(b, lt.nsec/1_000, 6)
+	default:
+		return codec.AppendInt(b, lt.nsec, 9)
+	}
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("localtime: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
 }
@@ -0,0 +1,55 @@
+package localtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+//nolint:gochecknoglobals // sink to prevent the compiler from optimising the benchmarks away.
+var sinkBool bool
+
+// BenchmarkBefore compares the field-wise comparison against the former implementation,
+// that converted both times to time.Time on a placeholder date.
+func BenchmarkBefore(b *testing.B) {
+	x, y := New(10, 0, 0, 0), New(11, 0, 0, 0)
+
+	b.Run("field-wise", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.Before(y)
+		}
+	})
+
+	b.Run("via time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			ld := localdate.New(2009, time.November, 10)
+			sinkBool = x.ToTime(ld, time.UTC).Before(y.ToTime(ld, time.UTC))
+		}
+	})
+}
+
+func BenchmarkEqual(b *testing.B) {
+	x, y := New(10, 0, 0, 0), New(10, 0, 0, 0)
+
+	b.Run("field-wise", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			sinkBool = x.Equal(y)
+		}
+	})
+
+	b.Run("via time.Time", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for range b.N {
+			ld := localdate.New(2009, time.November, 10)
+			sinkBool = x.ToTime(ld, time.UTC).Equal(y.ToTime(ld, time.UTC))
+		}
+	})
+}
@@ -1,9 +1,14 @@
 package localtime
 
 import (
+	"errors"
+	"math"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/manuelarte/gotimeplus/clock"
 	"github.com/manuelarte/gotimeplus/localdate"
 )
 
@@ -79,6 +84,35 @@ func TestAfter(t *testing.T) {
 	}
 }
 
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b   LocalTime
+		expect int
+	}{
+		"A is after B by hour":       {a: New(11, 0, 0, 0), b: New(10, 59, 59, 999_999_999), expect: 1},
+		"A is after B by nanosecond": {a: New(10, 15, 30, 1), b: New(10, 15, 30, 0), expect: 1},
+		"A is same as B":             {a: New(10, 15, 30, 0), b: New(10, 15, 30, 0), expect: 0},
+		"A is before B by minute":    {a: New(10, 14, 59, 0), b: New(10, 15, 0, 0), expect: -1},
+		"Midnight is the first time": {a: LocalTime{}, b: New(0, 0, 0, 1), expect: -1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.expect {
+				t.Errorf("Compare: expected %v, got %v", test.expect, got)
+			}
+
+			if got := test.b.Compare(test.a); got != -test.expect {
+				t.Errorf("Compare reversed: expected %v, got %v", -test.expect, got)
+			}
+		})
+	}
+}
+
 func TestEqual(t *testing.T) {
 	t.Parallel()
 
@@ -149,3 +183,157 @@ func TestToTime(t *testing.T) {
 		})
 	}
 }
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hour, minutes, sec, nsec int
+		wantErr                  *RangeError
+	}{
+		"Valid time": {
+			hour: 23, minutes: 59, sec: 59, nsec: 999_999_999,
+		},
+		"Midnight": {},
+		"Hour 24": {
+			hour:    24,
+			wantErr: &RangeError{Field: "hour", Value: 24, Min: 0, Max: 23},
+		},
+		"Minute 61": {
+			hour: 12, minutes: 61,
+			wantErr: &RangeError{Field: "minute", Value: 61, Min: 0, Max: 59},
+		},
+		"Negative second": {
+			sec:     -1,
+			wantErr: &RangeError{Field: "second", Value: -1, Min: 0, Max: 59},
+		},
+		"One second of nanoseconds": {
+			nsec:    1_000_000_000,
+			wantErr: &RangeError{Field: "nanosecond", Value: 1_000_000_000, Min: 0, Max: 999_999_999},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.hour, test.minutes, test.sec, test.nsec)
+			if test.wantErr != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("Of = %v, want *RangeError", err)
+				}
+
+				if diff := cmp.Diff(test.wantErr, rangeErr); diff != "" {
+					t.Errorf("Of error mismatch (-want +got):\n%s", diff)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Of unexpected error %v", err)
+			}
+
+			if want := New(test.hour, test.minutes, test.sec, test.nsec); !got.Equal(want) {
+				t.Errorf("Of = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		hour, minutes, sec, nsec int
+		want                     LocalTime
+	}{
+		"Valid time is kept": {
+			hour: 10, minutes: 15, sec: 30, nsec: 1,
+			want: New(10, 15, 30, 1),
+		},
+		"25:61 rolls over midnight": {
+			hour: 25, minutes: 61,
+			want: New(2, 1, 0, 0),
+		},
+		"Negative minute": {
+			hour: 0, minutes: -1,
+			want: New(23, 59, 0, 0),
+		},
+		"Nanoseconds overflow": {
+			hour: 23, minutes: 59, sec: 59, nsec: 1_000_000_000,
+			want: New(0, 0, 0, 0),
+		},
+		"Large hour does not overflow": {
+			hour: 3_000_000,
+			want: New(0, 0, 0, 0),
+		},
+		"Large negative nanoseconds do not overflow": {
+			nsec: math.MinInt64,
+			want: New(0, 12, 43, 145_224_192),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Normalize(test.hour, test.minutes, test.sec, test.nsec)
+			if got.Hour() != test.want.Hour() || got.Min() != test.want.Min() ||
+				got.Sec() != test.want.Sec() || got.Nanosecond() != test.want.Nanosecond() {
+				t.Errorf("Normalize = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestZeroValue(t *testing.T) {
+	t.Parallel()
+
+	var zero LocalTime
+	if !zero.IsZero() || !zero.Equal(New(0, 0, 0, 0)) {
+		t.Errorf("zero value = %v, want midnight", zero)
+	}
+
+	if New(0, 0, 0, 1).IsZero() {
+		t.Errorf("IsZero = true, want false")
+	}
+}
+
+func TestComparable(t *testing.T) {
+	t.Parallel()
+
+	openings := map[LocalTime]string{
+		New(9, 0, 0, 0): "open",
+	}
+
+	if got := openings[New(9, 0, 0, 0)]; got != "open" {
+		t.Errorf("map lookup = %q, want %q", got, "open")
+	}
+}
+
+func TestNow(t *testing.T) {
+	t.Parallel()
+
+	c := clock.Fixed(time.Date(2024, time.March, 5, 23, 30, 15, 0, time.UTC))
+
+	tests := map[string]struct {
+		loc  *time.Location
+		want LocalTime
+	}{
+		"UTC":  {loc: time.UTC, want: New(23, 30, 15, 0)},
+		"East": {loc: time.FixedZone("UTC+9", 9*3600), want: New(8, 30, 15, 0)},
+		"West": {loc: time.FixedZone("UTC-5:30", -(5*3600 + 1800)), want: New(18, 0, 15, 0)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Now(c, test.loc); got != test.want {
+				t.Errorf("Now = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
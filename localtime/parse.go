@@ -0,0 +1,115 @@
+package localtime
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+// ErrSyntax indicates that a value does not have any of the ISO-8601 time formats supported by Parse.
+var ErrSyntax = errors.New("invalid ISO-8601 time syntax")
+
+// ParseError describes a problem parsing a LocalTime.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure, either ErrSyntax or a *RangeError.
+	Err error
+}
+
+// Parse a LocalTime from one of the following ISO-8601 formats:
+//   - extended, hh:mm, hh:mm:ss or hh:mm:ss.fffffffff, e.g. 10:15:30.5.
+//   - basic, hhmm, hhmmss or hhmmss.fffffffff, e.g. 101530.5.
+//
+// The fraction of second can have from 1 to 9 digits, and can be separated by a dot or a comma.
+// Returns a *ParseError if the value can't be parsed or the time does not exist.
+func Parse(s string) (LocalTime, error) {
+	lt, err := parse(s)
+	if err != nil {
+		return LocalTime{}, &ParseError{Value: s, Err: err}
+	}
+
+	return lt, nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("localtime: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parse(s string) (LocalTime, error) {
+	extended := len(s) > 2 && s[2] == ':'
+
+	var hour, minutes, sec, nsec int
+
+	var ok bool
+
+	if hour, ok = atoi(s, 0, 2); !ok {
+		return LocalTime{}, ErrSyntax
+	}
+
+	i := 2
+	if extended {
+		i++
+	}
+
+	if minutes, ok = atoi(s, i, i+2); !ok {
+		return LocalTime{}, ErrSyntax
+	}
+
+	i += 2
+	if i < len(s) {
+		if extended {
+			if s[i] != ':' {
+				return LocalTime{}, ErrSyntax
+			}
+
+			i++
+		}
+
+		if sec, ok = atoi(s, i, i+2); !ok {
+			return LocalTime{}, ErrSyntax
+		}
+
+		i += 2
+	}
+
+	if i < len(s) {
+		if nsec, ok = parseFraction(s[i:]); !ok {
+			return LocalTime{}, ErrSyntax
+		}
+	}
+
+	return Of(hour, minutes, sec, nsec)
+}
+
+// parseFraction parses a decimal fraction of second, e.g. ".5", into nanoseconds.
+func parseFraction(s string) (int, bool) {
+	if len(s) < 2 || len(s) > 10 || (s[0] != '.' && s[0] != ',') {
+		return 0, false
+	}
+
+	nsec, ok := atoi(s, 1, len(s))
+	if !ok {
+		return 0, false
+	}
+
+	for range 10 - len(s) {
+		nsec *= 10
+	}
+
+	return nsec, true
+}
+
+// atoi parses s[from:to], that must be non-empty and made only of ASCII digits.
+func atoi(s string, from, to int) (int, bool) {
+	if from >= to || to > len(s) {
+		return 0, false
+	}
+
+	return codec.Atoi(s[from:to])
+}
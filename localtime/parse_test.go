@@ -0,0 +1,111 @@
+package localtime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  LocalTime
+	}{
+		"Hours and minutes":             {value: "10:15", want: New(10, 15, 0, 0)},
+		"Seconds":                       {value: "10:15:30", want: New(10, 15, 30, 0)},
+		"Milliseconds":                  {value: "10:15:30.123", want: New(10, 15, 30, 123_000_000)},
+		"Single digit fraction":         {value: "10:15:30.5", want: New(10, 15, 30, 500_000_000)},
+		"Nanoseconds":                   {value: "23:59:59.999999999", want: New(23, 59, 59, 999_999_999)},
+		"Comma as decimal separator":    {value: "10:15:30,5", want: New(10, 15, 30, 500_000_000)},
+		"Basic hours and minutes":       {value: "1015", want: New(10, 15, 0, 0)},
+		"Basic with seconds and millis": {value: "101530.123", want: New(10, 15, 30, 123_000_000)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil {
+				t.Fatalf("Parse unexpected error %v", err)
+			}
+
+			if got != test.want {
+				t.Errorf("Parse = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     string
+		wantRange bool
+	}{
+		"Empty":                    {value: ""},
+		"Hour only":                {value: "10"},
+		"Single digit hour":        {value: "1:15"},
+		"Mixed basic and extended": {value: "10:1530"},
+		"Trailing zone":            {value: "10:15:30Z"},
+		"Empty fraction":           {value: "10:15:30."},
+		"Ten digit fraction":       {value: "10:15:30.1234567890"},
+		"Hour 24":                  {value: "24:00", wantRange: true},
+		"Minute 60":                {value: "10:60", wantRange: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse error = %v, want *ParseError", err)
+			}
+
+			var rangeErr *RangeError
+			if got := errors.As(err, &rangeErr); got != test.wantRange {
+				t.Errorf("Parse error = %v, want range error %v", err, test.wantRange)
+			}
+
+			if !test.wantRange && !errors.Is(err, ErrSyntax) {
+				t.Errorf("Parse error = %v, want ErrSyntax", err)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		localTime LocalTime
+		want      string
+	}{
+		"Midnight":     {localTime: New(0, 0, 0, 0), want: "00:00:00"},
+		"Seconds":      {localTime: New(10, 15, 30, 0), want: "10:15:30"},
+		"Milliseconds": {localTime: New(10, 15, 30, 500_000_000), want: "10:15:30.500"},
+		"Microseconds": {localTime: New(10, 15, 30, 123_456_000), want: "10:15:30.123456"},
+		"Nanoseconds":  {localTime: New(10, 15, 30, 1), want: "10:15:30.000000001"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.localTime.String()
+			if got != test.want {
+				t.Errorf("String = %q, want %q", got, test.want)
+			}
+
+			parsed, err := Parse(got)
+			if err != nil || parsed != test.localTime {
+				t.Errorf("Parse(String()) = %v, %v, want %v", parsed, err, test.localTime)
+			}
+		})
+	}
+}
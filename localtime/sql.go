@@ -0,0 +1,82 @@
+package localtime
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ sql.Scanner   = (*LocalTime)(nil)
+	_ driver.Valuer = LocalTime{}
+	_ sql.Scanner   = (*NullLocalTime)(nil)
+	_ driver.Valuer = NullLocalTime{}
+)
+
+// NullLocalTime represents a LocalTime that may be null, e.g. a nullable TIME column.
+// Same concept as sql.NullTime.
+type NullLocalTime struct {
+	LocalTime LocalTime
+	// Valid is true if LocalTime is not NULL.
+	Valid bool
+}
+
+// Scan implements sql.Scanner so a TIME column can be scanned into a LocalTime.
+// It accepts time.Time, whose date and location are ignored, and []byte or string values in any of the formats
+// supported by Parse, optionally preceded by a date part, e.g. 0000-01-01 10:15:30.
+// NULL values are rejected, use NullLocalTime for nullable columns.
+func (lt *LocalTime) Scan(src any) error {
+	fromTime := func(t time.Time) {
+		*lt = New(t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+	}
+
+	return codec.Scan(src, "localtime", "LocalTime", fromTime, lt.scanText)
+}
+
+// Value implements driver.Valuer, sending the LocalTime as an ISO-8601 string, e.g. 10:15:30,
+// so no time-zone conversion can happen on the way to the database.
+func (lt LocalTime) Value() (driver.Value, error) {
+	return lt.String(), nil
+}
+
+func (lt *LocalTime) scanText(s string) error {
+	// Some drivers return TIME values with a placeholder date, e.g. 0000-01-01 10:15:30.
+	if i := strings.IndexAny(s, " T"); i >= 0 {
+		s = s[i+1:]
+	}
+
+	parsed, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*lt = parsed
+
+	return nil
+}
+
+// Scan implements sql.Scanner, accepting NULL and the same values as LocalTime.Scan.
+func (n *NullLocalTime) Scan(src any) error {
+	if src == nil {
+		n.LocalTime, n.Valid = LocalTime{}, false
+
+		return nil
+	}
+
+	err := n.LocalTime.Scan(src)
+	n.Valid = err == nil
+
+	return err
+}
+
+// Value implements driver.Valuer, sending NULL if the NullLocalTime is not valid.
+func (n NullLocalTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil //nolint:nilnil // NULL is represented by a nil value.
+	}
+
+	return n.LocalTime.Value()
+}
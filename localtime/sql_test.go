@@ -0,0 +1,77 @@
+package localtime
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+)
+
+func TestScan(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src     any
+		want    LocalTime
+		wantErr bool
+	}{
+		"time.Time keeps the wall clock": {
+			src:  time.Date(0, time.January, 1, 10, 15, 30, 5, time.FixedZone("UTC+2", 2*60*60)),
+			want: New(10, 15, 30, 5),
+		},
+		"Bytes":                   {src: []byte("10:15:30.123456"), want: New(10, 15, 30, 123_456_000)},
+		"String":                  {src: "10:15:30", want: New(10, 15, 30, 0)},
+		"String with placeholder": {src: "0000-01-01 10:15:30", want: New(10, 15, 30, 0)},
+		"NULL":                    {src: nil, wantErr: true},
+		"Unsupported type":        {src: 1.5, wantErr: true},
+		"Out of range":            {src: "838:59:59", wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got LocalTime
+
+			err := got.Scan(test.src)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Scan = %v, want error", got)
+				}
+
+				return
+			}
+
+			if err != nil || got != test.want {
+				t.Errorf("Scan = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestNullLocalTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		src       any
+		want      NullLocalTime
+		wantValue driver.Value
+	}{
+		"NULL": {src: nil, want: NullLocalTime{}, wantValue: nil},
+		"Time": {src: "09:00", want: NullLocalTime{LocalTime: New(9, 0, 0, 0), Valid: true}, wantValue: "09:00:00"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NullLocalTime{LocalTime: New(1, 0, 0, 0), Valid: true}
+			if err := got.Scan(test.src); err != nil || got != test.want {
+				t.Fatalf("Scan = %v, %v, want %v", got, err, test.want)
+			}
+
+			if value, err := got.Value(); err != nil || value != test.wantValue {
+				t.Errorf("Value = %v, %v, want %v", value, err, test.wantValue)
+			}
+		})
+	}
+}
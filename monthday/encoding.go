@@ -0,0 +1,36 @@
+package monthday
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = MonthDay{}
+	_ encoding.TextUnmarshaler = (*MonthDay)(nil)
+	_ json.Marshaler           = MonthDay{}
+	_ json.Unmarshaler         = (*MonthDay)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the MonthDay as an ISO-8601 string, e.g. "--02-29".
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	return codec.MarshalJSON(len("--MM-DD"), md.appendFormat), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the MonthDay in the ISO-8601 format.
+func (md MonthDay) MarshalText() ([]byte, error) {
+	return md.appendFormat(make([]byte, 0, len("--MM-DD"))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the formats supported by Parse.
+// A JSON null leaves the MonthDay unchanged.
+func (md *MonthDay) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "monthday: MonthDay", md.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the formats supported by Parse.
+func (md *MonthDay) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(md, data, Parse)
+}
@@ -0,0 +1,53 @@
+package monthday
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type reminder struct {
+	Birthday MonthDay  `json:"birthday"`
+	Reminder *MonthDay `json:"reminder"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	value := reminder{Birthday: New(time.February, 29)}
+	want := `{"birthday":"--02-29","reminder":null}`
+
+	data, err := json.Marshal(value)
+	if err != nil || string(data) != want {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, want)
+	}
+
+	var got reminder
+	if err = json.Unmarshal(data, &got); err != nil || got != value {
+		t.Errorf("json.Unmarshal = %+v, %v, want %+v", got, err, value)
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"birthday":229}`, `{"birthday":"--02-30"}`} {
+		var got reminder
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, want error", data, got)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	var got MonthDay
+	if err := got.UnmarshalText([]byte("--12-25")); err != nil || got != New(time.December, 25) {
+		t.Errorf("UnmarshalText = %v, %v, want --12-25", got, err)
+	}
+
+	if text, err := got.MarshalText(); err != nil || string(text) != "--12-25" {
+		t.Errorf("MarshalText = %q, %v, want --12-25", text, err)
+	}
+}
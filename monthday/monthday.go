@@ -0,0 +1,184 @@
+// Package monthday provides MonthDay, storing a month and a day of month, without a year or timezone.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/MonthDay.html.
+package monthday
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ fmt.Stringer = MonthDay{}
+
+type (
+	// MonthDay is a month and day of month without a year, e.g. --02-29, as used for birthdays, anniversaries or
+	// recurring reminders.
+	// It is a comparable value, so it can be used with == and as a map key.
+	// The zero value is January 1st.
+	MonthDay struct {
+		// The fields are stored as offsets from January 1st, so the zero value is a valid day.
+		month time.Month
+		day   int
+	}
+
+	// RangeError is returned by Of when a field of the month-day is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, e.g. "month" or "day".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
+)
+
+// New MonthDay from month and day.
+// The values are not validated, use Of to reject month-days like February 30th.
+func New(month time.Month, day int) MonthDay {
+	return MonthDay{
+		month: month - time.January,
+		day:   day - 1,
+	}
+}
+
+// Of MonthDay from month and day, validating that the day exists in the month in at least one year, so
+// February 29th is allowed.
+// Returns a *RangeError if the month is not in [1, 12] or the day does not exist in that month.
+func Of(month time.Month, day int) (MonthDay, error) {
+	if month < time.January || month > time.December {
+		return MonthDay{}, &RangeError{Field: "month", Value: int(month), Min: int(time.January), Max: int(time.December)}
+	}
+
+	// 2000 is a leap year, so February has 29 days.
+	if maxDay := localdate.New(2000, month, 1).LengthOfMonth(); day < 1 || day > maxDay {
+		return MonthDay{}, &RangeError{Field: "day", Value: day, Min: 1, Max: maxDay}
+	}
+
+	return New(month, day), nil
+}
+
+// FromLocalDate returns the MonthDay of the LocalDate.
+func FromLocalDate(ld localdate.LocalDate) MonthDay {
+	return New(ld.Month(), ld.Day())
+}
+
+// FromTime returns the MonthDay of the time.Time, in its location.
+func FromTime(t time.Time) MonthDay {
+	return New(t.Month(), t.Day())
+}
+
+// After reports whether the MonthDay is after the given other MonthDay.
+func (md MonthDay) After(other MonthDay) bool {
+	return md.Compare(other) > 0
+}
+
+// AtYear returns the LocalDate of the MonthDay in the given year.
+// February 29th in a non leap year is resolved with the policy, and false is returned if it's skipped or if the
+// MonthDay does not exist, e.g. February 30th.
+func (md MonthDay) AtYear(year int, policy localdate.LeapDayPolicy) (localdate.LocalDate, bool) {
+	first, err := localdate.Of(year, md.Month(), 1)
+	if err != nil || md.day < 0 {
+		return localdate.LocalDate{}, false
+	}
+
+	if md.Day() <= first.LengthOfMonth() {
+		return localdate.New(year, md.Month(), md.Day()), true
+	}
+
+	if !md.isLeapDay() {
+		return localdate.LocalDate{}, false
+	}
+
+	switch policy {
+	case localdate.LeapDayFeb28:
+		return localdate.New(year, time.February, 28), true
+	case localdate.LeapDayMar1:
+		return localdate.New(year, time.March, 1), true
+	default:
+		return localdate.LocalDate{}, false
+	}
+}
+
+// Before reports whether the MonthDay is before the given other MonthDay.
+func (md MonthDay) Before(other MonthDay) bool {
+	return md.Compare(other) < 0
+}
+
+// Compare returns -1 if the MonthDay is before other in the calendar year, 0 if they are equal, and +1 if it's
+// after.
+func (md MonthDay) Compare(other MonthDay) int {
+	if md.month != other.month {
+		return cmp.Compare(md.month, other.month)
+	}
+
+	return cmp.Compare(md.day, other.day)
+}
+
+func (md MonthDay) Day() int {
+	return md.day + 1
+}
+
+// Equal reports whether the MonthDay is equal to the given other MonthDay.
+// It's the same as using ==.
+func (md MonthDay) Equal(other MonthDay) bool {
+	return md == other
+}
+
+// IsValidYear reports whether the MonthDay exists in the given year, i.e. it's not February 29th in a non leap
+// year.
+func (md MonthDay) IsValidYear(year int) bool {
+	_, ok := md.AtYear(year, localdate.LeapDaySkip)
+
+	return ok
+}
+
+// IsZero reports whether the MonthDay is the zero value, January 1st.
+func (md MonthDay) IsZero() bool {
+	return md == MonthDay{}
+}
+
+func (md MonthDay) Month() time.Month {
+	return md.month + time.January
+}
+
+// NextOccurrence returns the first occurrence of the MonthDay on or after from, resolving February 29th with
+// the policy.
+// With localdate.LeapDaySkip February 29th only occurs in leap years, which can be up to 8 years away.
+// Returns false if the MonthDay never occurs, e.g. February 30th.
+func (md MonthDay) NextOccurrence(
+	from localdate.LocalDate,
+	policy localdate.LeapDayPolicy,
+) (localdate.LocalDate, bool) {
+	// Century years that are not leap years, like 2100, make the longest gap between leap years 8 years.
+	for year := from.Year(); year <= from.Year()+8; year++ {
+		if ld, ok := md.AtYear(year, policy); ok && !ld.Before(from) {
+			return ld, true
+		}
+	}
+
+	return localdate.LocalDate{}, false
+}
+
+// String returns the MonthDay in the ISO-8601 format, e.g. --02-29.
+func (md MonthDay) String() string {
+	return string(md.appendFormat(make([]byte, 0, len("--MM-DD"))))
+}
+
+func (md MonthDay) appendFormat(b []byte) []byte {
+	b = append(b, '-', '-')
+	b = codec.AppendInt(b, int(md.Month()), 2)
+	b = append(b, '-')
+
+	return codec.AppendInt(b, md.Day(), 2)
+}
+
+func (md MonthDay) isLeapDay() bool {
+	return md.Month() == time.February && md.Day() == 29
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("monthday: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
@@ -0,0 +1,260 @@
+package monthday
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		month   time.Month
+		day     int
+		wantErr bool
+	}{
+		"Leap day":     {month: time.February, day: 29},
+		"December 31":  {month: time.December, day: 31},
+		"February 30":  {month: time.February, day: 30, wantErr: true},
+		"April 31":     {month: time.April, day: 31, wantErr: true},
+		"Day 0":        {month: time.January, day: 0, wantErr: true},
+		"Month 13":     {month: 13, day: 1, wantErr: true},
+		"Month 0":      {month: 0, day: 1, wantErr: true},
+		"January 1":    {month: time.January, day: 1},
+		"September 30": {month: time.September, day: 30},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.month, test.day)
+			if test.wantErr {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Errorf("Of error = %v, want *RangeError", err)
+				}
+
+				return
+			}
+
+			if err != nil || got.Month() != test.month || got.Day() != test.day {
+				t.Errorf("Of = %v, %v, want --%02d-%02d", got, err, test.month, test.day)
+			}
+		})
+	}
+}
+
+func TestAtYear(t *testing.T) {
+	t.Parallel()
+
+	leapDay := New(time.February, 29)
+
+	tests := map[string]struct {
+		md     MonthDay
+		year   int
+		policy localdate.LeapDayPolicy
+		want   localdate.LocalDate
+		wantOk bool
+	}{
+		"Regular day": {
+			md:     New(time.July, 4),
+			year:   2023,
+			policy: localdate.LeapDaySkip,
+			want:   localdate.New(2023, time.July, 4),
+			wantOk: true,
+		},
+		"Leap day in leap year": {
+			md:     leapDay,
+			year:   2024,
+			policy: localdate.LeapDaySkip,
+			want:   localdate.New(2024, time.February, 29),
+			wantOk: true,
+		},
+		"Leap day in non leap year, Feb 28": {
+			md:     leapDay,
+			year:   2023,
+			policy: localdate.LeapDayFeb28,
+			want:   localdate.New(2023, time.February, 28),
+			wantOk: true,
+		},
+		"Leap day in non leap year, Mar 1": {
+			md:     leapDay,
+			year:   2023,
+			policy: localdate.LeapDayMar1,
+			want:   localdate.New(2023, time.March, 1),
+			wantOk: true,
+		},
+		"Leap day in non leap year, skip": {
+			md:     leapDay,
+			year:   1900,
+			policy: localdate.LeapDaySkip,
+		},
+		"Day that never exists": {
+			md:     New(time.February, 30),
+			year:   2024,
+			policy: localdate.LeapDayMar1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := test.md.AtYear(test.year, test.policy)
+			if ok != test.wantOk || got != test.want {
+				t.Errorf("AtYear = %v, %v, want %v, %v", got, ok, test.want, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestIsValidYear(t *testing.T) {
+	t.Parallel()
+
+	leapDay := New(time.February, 29)
+
+	if !leapDay.IsValidYear(2000) {
+		t.Error("IsValidYear(2000) = false, want true")
+	}
+
+	if leapDay.IsValidYear(1900) {
+		t.Error("IsValidYear(1900) = true, want false")
+	}
+
+	if !New(time.February, 28).IsValidYear(1900) {
+		t.Error("IsValidYear(1900) = false, want true")
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		md     MonthDay
+		from   localdate.LocalDate
+		policy localdate.LeapDayPolicy
+		want   localdate.LocalDate
+		wantOk bool
+	}{
+		"Later this year": {
+			md:     New(time.December, 25),
+			from:   localdate.New(2024, time.June, 1),
+			want:   localdate.New(2024, time.December, 25),
+			wantOk: true,
+		},
+		"Same day": {
+			md:     New(time.June, 1),
+			from:   localdate.New(2024, time.June, 1),
+			want:   localdate.New(2024, time.June, 1),
+			wantOk: true,
+		},
+		"Next year": {
+			md:     New(time.January, 1),
+			from:   localdate.New(2024, time.June, 1),
+			want:   localdate.New(2025, time.January, 1),
+			wantOk: true,
+		},
+		"Leap day, Feb 28": {
+			md:     New(time.February, 29),
+			from:   localdate.New(2024, time.March, 1),
+			policy: localdate.LeapDayFeb28,
+			want:   localdate.New(2025, time.February, 28),
+			wantOk: true,
+		},
+		"Leap day, Mar 1 on from": {
+			md:     New(time.February, 29),
+			from:   localdate.New(2023, time.March, 1),
+			policy: localdate.LeapDayMar1,
+			want:   localdate.New(2023, time.March, 1),
+			wantOk: true,
+		},
+		"Leap day, skip": {
+			md:     New(time.February, 29),
+			from:   localdate.New(2024, time.March, 1),
+			policy: localdate.LeapDaySkip,
+			want:   localdate.New(2028, time.February, 29),
+			wantOk: true,
+		},
+		"Leap day, skip over a century": {
+			md:     New(time.February, 29),
+			from:   localdate.New(2097, time.March, 1),
+			policy: localdate.LeapDaySkip,
+			want:   localdate.New(2104, time.February, 29),
+			wantOk: true,
+		},
+		"Never occurs": {
+			md:   New(time.April, 31),
+			from: localdate.New(2024, time.March, 1),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := test.md.NextOccurrence(test.from, test.policy)
+			if ok != test.wantOk || got != test.want {
+				t.Errorf("NextOccurrence = %v, %v, want %v, %v", got, ok, test.want, test.wantOk)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b MonthDay
+		want int
+	}{
+		"Before, same month":      {a: New(time.March, 1), b: New(time.March, 2), want: -1},
+		"Before, different month": {a: New(time.February, 29), b: New(time.March, 1), want: -1},
+		"Equal":                   {a: New(time.March, 1), b: New(time.March, 1), want: 0},
+		"After":                   {a: New(time.December, 1), b: New(time.January, 31), want: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.want {
+				t.Errorf("Compare = %d, want %d", got, test.want)
+			}
+
+			if got := test.a.Before(test.b); got != (test.want < 0) {
+				t.Errorf("Before = %v, want %v", got, test.want < 0)
+			}
+
+			if got := test.a.After(test.b); got != (test.want > 0) {
+				t.Errorf("After = %v, want %v", got, test.want > 0)
+			}
+
+			if got := test.a.Equal(test.b); got != (test.want == 0) {
+				t.Errorf("Equal = %v, want %v", got, test.want == 0)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	t.Parallel()
+
+	want := New(time.February, 29)
+
+	if got := FromLocalDate(localdate.New(2024, time.February, 29)); got != want {
+		t.Errorf("FromLocalDate = %v, want %v", got, want)
+	}
+
+	if got := FromTime(time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC)); got != want {
+		t.Errorf("FromTime = %v, want %v", got, want)
+	}
+
+	var zero MonthDay
+	if !zero.IsZero() || zero.String() != "--01-01" {
+		t.Errorf("zero value = %v, want --01-01", zero)
+	}
+}
@@ -0,0 +1,64 @@
+package monthday
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+// ErrSyntax indicates that a value is not an ISO-8601 month and day, e.g. --02-29.
+var ErrSyntax = errors.New("invalid ISO-8601 month-day syntax")
+
+// ParseError describes a problem parsing a MonthDay.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure, either ErrSyntax or a *RangeError.
+	Err error
+}
+
+// Parse a MonthDay from the ISO-8601 extended format --MM-DD, e.g. --02-29, or the basic format --MMDD.
+// Returns a *ParseError if the value can't be parsed or the month-day does not exist.
+func Parse(s string) (MonthDay, error) {
+	md, err := parse(s)
+	if err != nil {
+		return MonthDay{}, &ParseError{Value: s, Err: err}
+	}
+
+	return md, nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("monthday: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parse(s string) (MonthDay, error) {
+	var monthStr, dayStr string
+
+	switch {
+	case len(s) == len("--MM-DD") && s[:2] == "--" && s[4] == '-':
+		monthStr, dayStr = s[2:4], s[5:]
+	case len(s) == len("--MMDD") && s[:2] == "--":
+		monthStr, dayStr = s[2:4], s[4:]
+	default:
+		return MonthDay{}, ErrSyntax
+	}
+
+	month, ok := codec.Atoi(monthStr)
+	if !ok {
+		return MonthDay{}, ErrSyntax
+	}
+
+	day, ok := codec.Atoi(dayStr)
+	if !ok {
+		return MonthDay{}, ErrSyntax
+	}
+
+	return Of(time.Month(month), day)
+}
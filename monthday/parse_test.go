@@ -0,0 +1,73 @@
+package monthday
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  MonthDay
+	}{
+		"Extended format": {value: "--02-29", want: New(time.February, 29)},
+		"Basic format":    {value: "--1225", want: New(time.December, 25)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil || got != test.want {
+				t.Errorf("Parse = %v, %v, want %v", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value      string
+		wantSyntax bool
+	}{
+		"Empty":              {value: "", wantSyntax: true},
+		"Missing dashes":     {value: "02-29", wantSyntax: true},
+		"Full date":          {value: "2024-02-29", wantSyntax: true},
+		"Letters":            {value: "--FE-29", wantSyntax: true},
+		"Wrong separator":    {value: "--02/29", wantSyntax: true},
+		"Day does not exist": {value: "--02-30"},
+		"Month 13":           {value: "--13-01"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse error = %v, want *ParseError", err)
+			}
+
+			var rangeErr *RangeError
+			if errors.Is(err, ErrSyntax) != test.wantSyntax || errors.As(err, &rangeErr) == test.wantSyntax {
+				t.Errorf("Parse error = %v, want syntax error %v", err, test.wantSyntax)
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	t.Parallel()
+
+	if got := New(time.February, 9).String(); got != "--02-09" {
+		t.Errorf("String = %q, want --02-09", got)
+	}
+}
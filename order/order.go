@@ -0,0 +1,54 @@
+// Package order provides Min, Max and Clamp for the types with a Compare method, e.g. localdate.LocalDate or
+// timeperiod.TimePeriod, as the min and max builtins do for the ordered types.
+package order
+
+type (
+	// Comparable is a type with a Compare method returning -1, 0 or +1 depending on whether the value is before,
+	// equal to or after other.
+	Comparable[T any] interface {
+		Compare(other T) int
+	}
+)
+
+// Clamp returns v limited to the inclusive range [lo, hi].
+// Panics if lo is after hi.
+func Clamp[T Comparable[T]](v, lo, hi T) T {
+	if lo.Compare(hi) > 0 {
+		panic("order: Clamp with lo after hi")
+	}
+
+	switch {
+	case v.Compare(lo) < 0:
+		return lo
+	case v.Compare(hi) > 0:
+		return hi
+	default:
+		return v
+	}
+}
+
+// Max returns the greatest of the values, the first one if several are equal.
+func Max[T Comparable[T]](first T, rest ...T) T {
+	result := first
+
+	for _, v := range rest {
+		if v.Compare(result) > 0 {
+			result = v
+		}
+	}
+
+	return result
+}
+
+// Min returns the smallest of the values, the first one if several are equal.
+func Min[T Comparable[T]](first T, rest ...T) T {
+	result := first
+
+	for _, v := range rest {
+		if v.Compare(result) < 0 {
+			result = v
+		}
+	}
+
+	return result
+}
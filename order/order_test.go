@@ -0,0 +1,130 @@
+package order
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localtime"
+	"github.com/manuelarte/gotimeplus/timeperiod"
+)
+
+func TestMinMax(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values           []localdate.LocalDate
+		wantMin, wantMax localdate.LocalDate
+	}{
+		"Single value": {
+			values:  []localdate.LocalDate{localdate.New(2024, time.March, 5)},
+			wantMin: localdate.New(2024, time.March, 5),
+			wantMax: localdate.New(2024, time.March, 5),
+		},
+		"Several values": {
+			values: []localdate.LocalDate{
+				localdate.New(2024, time.March, 5),
+				localdate.New(2023, time.December, 31),
+				localdate.New(2024, time.March, 6),
+				localdate.New(2024, time.February, 29),
+			},
+			wantMin: localdate.New(2023, time.December, 31),
+			wantMax: localdate.New(2024, time.March, 6),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Min(test.values[0], test.values[1:]...); got != test.wantMin {
+				t.Errorf("Min = %v, want %v", got, test.wantMin)
+			}
+
+			if got := Max(test.values[0], test.values[1:]...); got != test.wantMax {
+				t.Errorf("Max = %v, want %v", got, test.wantMax)
+			}
+		})
+	}
+}
+
+func TestMinMaxTimePeriod(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	bounded := timeperiod.Must(&start, &end)
+	noStart := timeperiod.Must(nil, &end)
+	noEnd := timeperiod.Must(&start, nil)
+
+	if got := Min(bounded, noEnd, noStart); got != noStart {
+		t.Errorf("Min = %v, want the period without start", got)
+	}
+
+	if got := Max(noStart, noEnd, bounded); got != noEnd {
+		t.Errorf("Max = %v, want the period without end", got)
+	}
+}
+
+func TestClamp(t *testing.T) {
+	t.Parallel()
+
+	lo, hi := localtime.New(9, 0, 0, 0), localtime.New(17, 30, 0, 0)
+
+	tests := map[string]struct {
+		value localtime.LocalTime
+		want  localtime.LocalTime
+	}{
+		"Before":   {value: localtime.New(7, 45, 0, 0), want: lo},
+		"Lower":    {value: lo, want: lo},
+		"Inside":   {value: localtime.New(12, 0, 0, 0), want: localtime.New(12, 0, 0, 0)},
+		"Upper":    {value: hi, want: hi},
+		"After":    {value: localtime.New(17, 30, 0, 1), want: hi},
+		"Midnight": {value: localtime.LocalTime{}, want: lo},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Clamp(test.value, lo, hi); got != test.want {
+				t.Errorf("Clamp = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClampPanics(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Clamp did not panic")
+		}
+	}()
+
+	Clamp(localdate.New(2024, time.March, 5), localdate.New(2024, time.March, 6), localdate.New(2024, time.March, 5))
+}
+
+func TestSortFunc(t *testing.T) {
+	t.Parallel()
+
+	dates := []localdate.LocalDate{
+		localdate.New(2024, time.March, 5),
+		localdate.New(-1, time.December, 31),
+		localdate.New(2024, time.January, 5),
+	}
+
+	slices.SortFunc(dates, localdate.LocalDate.Compare)
+
+	want := []localdate.LocalDate{
+		localdate.New(-1, time.December, 31),
+		localdate.New(2024, time.January, 5),
+		localdate.New(2024, time.March, 5),
+	}
+	if !slices.Equal(dates, want) {
+		t.Errorf("SortFunc = %v, want %v", dates, want)
+	}
+}
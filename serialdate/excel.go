@@ -0,0 +1,156 @@
+package serialdate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+)
+
+// Excel date systems.
+const (
+	// Excel1900 is the default date system of Excel, where 1 is January 1, 1900.
+	// As in Lotus 1-2-3, 1900 is a leap year, so 60 is the phantom February 29, 1900, and 61 is March 1, 1900.
+	Excel1900 System = iota
+	// Excel1904 is the date system of the early Excel versions for Mac, where 0 is January 1, 1904.
+	Excel1904
+)
+
+const (
+	// excel1900Offset is the epoch day of the Excel 1900 serial 0 counting back from March 1, 1900, December 30, 1899,
+	// as the phantom leap day shifts the serials from March 1, 1900 by one day.
+	excel1900Offset = -25569
+	// excel1900PhantomLeapDay is the Excel 1900 serial of February 29, 1900.
+	excel1900PhantomLeapDay = 60
+	// excel1904Offset is the epoch day of the Excel 1904 serial 0, January 1, 1904.
+	excel1904Offset = -24107
+	// excelMaxEpochDay is the epoch day of December 31, 9999, the last date of Excel.
+	excelMaxEpochDay = 2_932_896
+	// excelPrecision is the precision of the times of Excel, a millisecond.
+	excelPrecision = 1_000_000
+)
+
+// System is an Excel date system, counting the days since its epoch.
+// Excel stores dates and times as serial numbers, the integer part being the date, and the fraction the time.
+type System int
+
+// Date returns the LocalDate of the serial.
+// Returns ErrPhantomLeapDay for the Excel1900 serial 60, or an error wrapping ErrOutOfRange if the serial is negative
+// or after December 31, 9999, or ErrUnknownSystem if the System is not Excel1900 or Excel1904.
+func (s System) Date(serial int) (localdate.LocalDate, error) {
+	if err := s.validate(); err != nil {
+		return localdate.LocalDate{}, err
+	}
+
+	if s == Excel1900 && serial == excel1900PhantomLeapDay {
+		return localdate.LocalDate{}, ErrPhantomLeapDay
+	}
+
+	epochDay := int64(serial) + s.offset(serial)
+	if serial < 0 || epochDay > excelMaxEpochDay {
+		return localdate.LocalDate{}, fmt.Errorf("%w: serial %d in the %v system", ErrOutOfRange, serial, s)
+	}
+
+	return localdate.OfEpochDay(epochDay), nil
+}
+
+// DateTime returns the LocalDateTime of the serial, whose fraction is the time, rounded to the millisecond.
+// Returns ErrPhantomLeapDay for the Excel1900 serials from 60 to 61, or an error wrapping ErrOutOfRange if the serial
+// is negative or after December 31, 9999, or ErrUnknownSystem if the System is not Excel1900 or Excel1904.
+func (s System) DateTime(serial float64) (localdatetime.LocalDateTime, error) {
+	if err := s.validate(); err != nil {
+		return localdatetime.LocalDateTime{}, err
+	}
+
+	if math.IsNaN(serial) || serial < 0 || serial > math.MaxInt32 {
+		return localdatetime.LocalDateTime{}, fmt.Errorf("%w: serial %v in the %v system", ErrOutOfRange, serial, s)
+	}
+
+	day := math.Floor(serial)
+
+	ld, err := s.Date(int(day))
+	if err != nil {
+		return localdatetime.LocalDateTime{}, err
+	}
+
+	ldt, err := fromDays(float64(ld.ToEpochDay())+serial-day, excelPrecision)
+	if err != nil || ldt.Date().ToEpochDay() > excelMaxEpochDay {
+		return localdatetime.LocalDateTime{}, fmt.Errorf("%w: serial %v in the %v system", ErrOutOfRange, serial, s)
+	}
+
+	return ldt, nil
+}
+
+// Serial returns the serial of the LocalDate.
+// Returns an error wrapping ErrOutOfRange if the date is before the serial 0 of the system, or after
+// December 31, 9999, or ErrUnknownSystem if the System is not Excel1900 or Excel1904.
+func (s System) Serial(ld localdate.LocalDate) (int, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+
+	epochDay := ld.ToEpochDay()
+
+	serial := epochDay - excel1904Offset
+	if s == Excel1900 {
+		serial = epochDay - excel1900Offset
+		if serial <= excel1900PhantomLeapDay {
+			serial--
+		}
+	}
+
+	if serial < 0 || epochDay > excelMaxEpochDay {
+		return 0, fmt.Errorf("%w: %v in the %v system", ErrOutOfRange, ld, s)
+	}
+
+	return int(serial), nil
+}
+
+// SerialDateTime returns the serial of the LocalDateTime, whose fraction is the time.
+// Returns an error wrapping ErrOutOfRange if the date is before the serial 0 of the system, or after
+// December 31, 9999, or ErrUnknownSystem if the System is not Excel1900 or Excel1904.
+func (s System) SerialDateTime(ldt localdatetime.LocalDateTime) (float64, error) {
+	if err := s.validate(); err != nil {
+		return 0, err
+	}
+
+	serial, err := s.Serial(ldt.Date())
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(serial) + toDays(ldt) - float64(ldt.Date().ToEpochDay()), nil
+}
+
+func (s System) String() string {
+	switch s {
+	case Excel1900:
+		return "Excel 1900"
+	case Excel1904:
+		return "Excel 1904"
+	default:
+		return fmt.Sprintf("System(%d)", int(s))
+	}
+}
+
+// offset returns the epoch day of the serial 0, to add to the serial to get its epoch day.
+func (s System) offset(serial int) int64 {
+	switch {
+	case s == Excel1904:
+		return excel1904Offset
+	case serial < excel1900PhantomLeapDay:
+		return excel1900Offset + 1
+	default:
+		return excel1900Offset
+	}
+}
+
+// validate returns an error wrapping ErrUnknownSystem if the System is not Excel1900 or Excel1904.
+func (s System) validate() error {
+	if s != Excel1900 && s != Excel1904 {
+		return fmt.Errorf("%w: %v", ErrUnknownSystem, s)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package serialdate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+)
+
+func TestSystemDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		system System
+		serial int
+		want   localdate.LocalDate
+	}{
+		"1900 serial 0":                {system: Excel1900, serial: 0, want: localdate.New(1899, time.December, 31)},
+		"1900 first day":               {system: Excel1900, serial: 1, want: localdate.New(1900, time.January, 1)},
+		"1900 before phantom leap day": {system: Excel1900, serial: 59, want: localdate.New(1900, time.February, 28)},
+		"1900 after phantom leap day":  {system: Excel1900, serial: 61, want: localdate.New(1900, time.March, 1)},
+		"1900 Unix epoch":              {system: Excel1900, serial: 25569, want: localdate.New(1970, time.January, 1)},
+		"1900 leap day":                {system: Excel1900, serial: 45351, want: localdate.New(2024, time.February, 29)},
+		"1900 last day":                {system: Excel1900, serial: 2958465, want: localdate.New(9999, time.December, 31)},
+		"1904 serial 0":                {system: Excel1904, serial: 0, want: localdate.New(1904, time.January, 1)},
+		"1904 serial 60":               {system: Excel1904, serial: 60, want: localdate.New(1904, time.March, 1)},
+		"1904 leap day":                {system: Excel1904, serial: 43889, want: localdate.New(2024, time.February, 29)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.system.Date(test.serial)
+			if err != nil || got != test.want {
+				t.Fatalf("Date = %v, %v, want %v", got, err, test.want)
+			}
+
+			serial, err := test.system.Serial(got)
+			if err != nil || serial != test.serial {
+				t.Errorf("Serial = %d, %v, want %d", serial, err, test.serial)
+			}
+		})
+	}
+}
+
+func TestSystemDateError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		system System
+		serial int
+		want   error
+	}{
+		"Phantom leap day": {system: Excel1900, serial: 60, want: ErrPhantomLeapDay},
+		"1900 negative":    {system: Excel1900, serial: -1, want: ErrOutOfRange},
+		"1900 after 9999":  {system: Excel1900, serial: 2958466, want: ErrOutOfRange},
+		"1904 negative":    {system: Excel1904, serial: -1, want: ErrOutOfRange},
+		"1904 after 9999":  {system: Excel1904, serial: 2957004, want: ErrOutOfRange},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.system.Date(test.serial); !errors.Is(err, test.want) {
+				t.Errorf("Date error = %v, want %v", err, test.want)
+			}
+
+			if _, err := test.system.DateTime(float64(test.serial) + 0.5); !errors.Is(err, test.want) {
+				t.Errorf("DateTime error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
+
+func TestSystemSerialError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		system System
+		ld     localdate.LocalDate
+	}{
+		"1900 before serial 0": {system: Excel1900, ld: localdate.New(1899, time.December, 30)},
+		"1904 before serial 0": {system: Excel1904, ld: localdate.New(1903, time.December, 31)},
+		"After 9999":           {system: Excel1904, ld: localdate.New(10000, time.January, 1)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := test.system.Serial(test.ld); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("Serial error = %v, want %v", err, ErrOutOfRange)
+			}
+		})
+	}
+}
+
+func TestSystemUnknown(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]System{
+		"Negative":     -1,
+		"After 1904":   Excel1904 + 1,
+		"Large system": 42,
+	}
+
+	for name, system := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := system.Date(45356); !errors.Is(err, ErrUnknownSystem) {
+				t.Errorf("Date error = %v, want %v", err, ErrUnknownSystem)
+			}
+
+			if _, err := system.DateTime(45356.5); !errors.Is(err, ErrUnknownSystem) {
+				t.Errorf("DateTime error = %v, want %v", err, ErrUnknownSystem)
+			}
+
+			if _, err := system.Serial(localdate.New(2024, time.March, 5)); !errors.Is(err, ErrUnknownSystem) {
+				t.Errorf("Serial error = %v, want %v", err, ErrUnknownSystem)
+			}
+
+			ldt := localdatetime.New(2024, time.March, 5, 12, 0, 0, 0)
+			if _, err := system.SerialDateTime(ldt); !errors.Is(err, ErrUnknownSystem) {
+				t.Errorf("SerialDateTime error = %v, want %v", err, ErrUnknownSystem)
+			}
+		})
+	}
+}
+
+func TestSystemDateTime(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		system System
+		serial float64
+		want   localdatetime.LocalDateTime
+	}{
+		"Noon": {
+			system: Excel1900,
+			serial: 45356.5,
+			want:   localdatetime.New(2024, time.March, 5, 12, 0, 0, 0),
+		},
+		"Milliseconds": {
+			system: Excel1900,
+			serial: 45356.781597222220,
+			want:   localdatetime.New(2024, time.March, 5, 18, 45, 30, 0),
+		},
+		"Before phantom leap day": {
+			system: Excel1900,
+			serial: 59.25,
+			want:   localdatetime.New(1900, time.February, 28, 6, 0, 0, 0),
+		},
+		"Time only": {
+			system: Excel1900,
+			serial: 0.75,
+			want:   localdatetime.New(1899, time.December, 31, 18, 0, 0, 0),
+		},
+		"1904": {
+			system: Excel1904,
+			serial: 43894.5,
+			want:   localdatetime.New(2024, time.March, 5, 12, 0, 0, 0),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := test.system.DateTime(test.serial)
+			if err != nil || got != test.want {
+				t.Fatalf("DateTime = %v, %v, want %v", got, err, test.want)
+			}
+
+			serial, err := test.system.SerialDateTime(got)
+			if err != nil || serial-test.serial > 1e-9 || test.serial-serial > 1e-9 {
+				t.Errorf("SerialDateTime = %v, %v, want %v", serial, err, test.serial)
+			}
+		})
+	}
+}
+
+func TestSystemString(t *testing.T) {
+	t.Parallel()
+
+	tests := map[System]string{Excel1900: "Excel 1900", Excel1904: "Excel 1904", 2: "System(2)"}
+	for system, want := range tests {
+		if got := system.String(); got != want {
+			t.Errorf("String = %q, want %q", got, want)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package serialdate
+
+import (
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+)
+
+const (
+	// julianDayOffset is the Julian Day Number of 1970-01-01.
+	julianDayOffset = 2_440_588
+	// modifiedJulianDayOffset is the Modified Julian Day of 1970-01-01, the days since 1858-11-17.
+	modifiedJulianDayOffset = 40_587
+	// julianPrecision is the precision of the times of the Julian Dates, a millisecond, as a float64 Julian Date only
+	// keeps about 50 microseconds.
+	julianPrecision = 1_000_000
+)
+
+// FromJulianDate returns the LocalDateTime, in Universal Time, of the Julian Date, rounded to the millisecond.
+// Returns ErrOutOfRange if jd is not finite or too large.
+func FromJulianDate(jd float64) (localdatetime.LocalDateTime, error) {
+	return fromDays(jd-julianDayOffset+0.5, julianPrecision)
+}
+
+// FromJulianDayNumber returns the LocalDate of the Julian Day Number.
+func FromJulianDayNumber(jdn int64) localdate.LocalDate {
+	return localdate.OfEpochDay(jdn - julianDayOffset)
+}
+
+// FromModifiedJulianDate returns the LocalDateTime, in Universal Time, of the Modified Julian Date, rounded to the
+// millisecond.
+// Returns ErrOutOfRange if mjd is not finite or too large.
+func FromModifiedJulianDate(mjd float64) (localdatetime.LocalDateTime, error) {
+	return fromDays(mjd-modifiedJulianDayOffset, julianPrecision)
+}
+
+// FromModifiedJulianDay returns the LocalDate of the Modified Julian Day.
+func FromModifiedJulianDay(mjd int64) localdate.LocalDate {
+	return localdate.OfEpochDay(mjd - modifiedJulianDayOffset)
+}
+
+// JulianDate returns the Julian Date of the LocalDateTime in Universal Time, the days since noon of January 1,
+// 4713 BC in the proleptic Julian calendar, e.g. 2451545.0 for 2000-01-01T12:00.
+func JulianDate(ldt localdatetime.LocalDateTime) float64 {
+	return toDays(ldt) + julianDayOffset - 0.5
+}
+
+// JulianDayNumber returns the Julian Day Number of the LocalDate, the Julian Date at its noon, e.g. 2451545 for
+// 2000-01-01.
+func JulianDayNumber(ld localdate.LocalDate) int64 {
+	return ld.ToEpochDay() + julianDayOffset
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of the LocalDateTime in Universal Time, the Julian Date minus
+// 2400000.5, so the days start at midnight, e.g. 51544.5 for 2000-01-01T12:00.
+func ModifiedJulianDate(ldt localdatetime.LocalDateTime) float64 {
+	return toDays(ldt) + modifiedJulianDayOffset
+}
+
+// ModifiedJulianDay returns the Modified Julian Day of the LocalDate, the days since 1858-11-17, e.g. 51544 for
+// 2000-01-01.
+func ModifiedJulianDay(ld localdate.LocalDate) int64 {
+	return ld.ToEpochDay() + modifiedJulianDayOffset
+}
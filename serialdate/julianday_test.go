@@ -0,0 +1,119 @@
+package serialdate
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+)
+
+func TestJulianDayNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ld       localdate.LocalDate
+		jdn, mjd int64
+	}{
+		"J2000":                     {ld: localdate.New(2000, time.January, 1), jdn: 2_451_545, mjd: 51_544},
+		"Unix epoch":                {ld: localdate.New(1970, time.January, 1), jdn: 2_440_588, mjd: 40_587},
+		"Modified Julian Day epoch": {ld: localdate.New(1858, time.November, 17), jdn: 2_400_001, mjd: 0},
+		"Gregorian reform":          {ld: localdate.New(1582, time.October, 15), jdn: 2_299_161, mjd: -100_840},
+		"Julian Day epoch":          {ld: localdate.New(-4713, time.November, 24), jdn: 0, mjd: -2_400_001},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := JulianDayNumber(test.ld); got != test.jdn {
+				t.Errorf("JulianDayNumber = %d, want %d", got, test.jdn)
+			}
+
+			if got := FromJulianDayNumber(test.jdn); got != test.ld {
+				t.Errorf("FromJulianDayNumber = %v, want %v", got, test.ld)
+			}
+
+			if got := ModifiedJulianDay(test.ld); got != test.mjd {
+				t.Errorf("ModifiedJulianDay = %d, want %d", got, test.mjd)
+			}
+
+			if got := FromModifiedJulianDay(test.mjd); got != test.ld {
+				t.Errorf("FromModifiedJulianDay = %v, want %v", got, test.ld)
+			}
+		})
+	}
+}
+
+func TestJulianDate(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ldt     localdatetime.LocalDateTime
+		jd, mjd float64
+	}{
+		"J2000": {
+			ldt: localdatetime.New(2000, time.January, 1, 12, 0, 0, 0),
+			jd:  2_451_545,
+			mjd: 51_544.5,
+		},
+		"Midnight": {
+			ldt: localdatetime.New(2000, time.January, 1, 0, 0, 0, 0),
+			jd:  2_451_544.5,
+			mjd: 51_544,
+		},
+		"Milliseconds": {
+			ldt: localdatetime.New(2024, time.March, 5, 18, 45, 30, 123_000_000),
+			jd:  2_460_375.281598646,
+			mjd: 60_374.781598646,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := JulianDate(test.ldt); math.Abs(got-test.jd) > 1e-8 {
+				t.Errorf("JulianDate = %v, want %v", got, test.jd)
+			}
+
+			if got, err := FromJulianDate(JulianDate(test.ldt)); err != nil || got != test.ldt {
+				t.Errorf("FromJulianDate = %v, %v, want %v", got, err, test.ldt)
+			}
+
+			if got := ModifiedJulianDate(test.ldt); math.Abs(got-test.mjd) > 1e-8 {
+				t.Errorf("ModifiedJulianDate = %v, want %v", got, test.mjd)
+			}
+
+			if got, err := FromModifiedJulianDate(ModifiedJulianDate(test.ldt)); err != nil || got != test.ldt {
+				t.Errorf("FromModifiedJulianDate = %v, %v, want %v", got, err, test.ldt)
+			}
+		})
+	}
+}
+
+func TestFromJulianDateError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]float64{
+		"NaN":       math.NaN(),
+		"Infinite":  math.Inf(1),
+		"Too large": 1e300,
+	}
+
+	for name, jd := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := FromJulianDate(jd); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("FromJulianDate error = %v, want %v", err, ErrOutOfRange)
+			}
+
+			if _, err := FromModifiedJulianDate(-jd); !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("FromModifiedJulianDate error = %v, want %v", err, ErrOutOfRange)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Package serialdate converts LocalDate and LocalDateTime values from and to serial day counts, like the Excel serial
+// dates, the Julian Day Numbers and the Modified Julian Dates.
+package serialdate
+
+import (
+	"errors"
+	"math"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/localdatetime"
+	"github.com/manuelarte/gotimeplus/localtime"
+)
+
+const nanosPerDay = 86_400_000_000_000
+
+var (
+	// ErrOutOfRange indicates that a date or a serial is outside the range supported by the conversion.
+	ErrOutOfRange = errors.New("serialdate: out of range")
+
+	// ErrPhantomLeapDay indicates the Excel 1900 serial 60, February 29, 1900, a day that does not exist.
+	ErrPhantomLeapDay = errors.New("serialdate: serial 60 is February 29, 1900, which does not exist")
+
+	// ErrUnknownSystem indicates a System other than Excel1900 and Excel1904.
+	ErrUnknownSystem = errors.New("serialdate: unknown system")
+)
+
+// toDays returns the days since 1970-01-01 of ldt, with the time as the fraction of the day.
+func toDays(ldt localdatetime.LocalDateTime) float64 {
+	lt := ldt.Time()
+	nanos := ((int64(lt.Hour())*60+int64(lt.Min()))*60+int64(lt.Sec()))*1e9 + int64(lt.Nanosecond())
+
+	return float64(ldt.Date().ToEpochDay()) + float64(nanos)/nanosPerDay
+}
+
+// fromDays returns the LocalDateTime of a number of days since 1970-01-01, with the fraction of the day as the time,
+// rounded to a multiple of precision nanoseconds, as a float64 can't hold nanoseconds over such a range.
+// Returns ErrOutOfRange if days is not finite or exceeds the integers a float64 represents exactly.
+func fromDays(days float64, precision int64) (localdatetime.LocalDateTime, error) {
+	if math.IsNaN(days) || math.Abs(days) > 1<<53 {
+		return localdatetime.LocalDateTime{}, ErrOutOfRange
+	}
+
+	day := math.Floor(days)
+
+	nanos := int64(math.Round((days-day)*nanosPerDay/float64(precision))) * precision
+	if nanos >= nanosPerDay {
+		day, nanos = day+1, 0
+	}
+
+	return localdatetime.NewFrom(
+		localdate.OfEpochDay(int64(day)),
+		localtime.Normalize(0, 0, 0, int(nanos)),
+	), nil
+}
@@ -3,6 +3,7 @@
 package timeperiod
 
 import (
+	"cmp"
 	"errors"
 	"time"
 )
@@ -26,6 +27,10 @@ type (
 		StartTime() *time.Time
 		EndTime() *time.Time
 
+		// Compare Returns -1, 0 or +1 depending on whether this period is before, equal to or after the other one,
+		// ordering by start time, and then by end time.
+		// A nil start time is before any other start time, and a nil end time is after any other end time.
+		Compare(other TimePeriod) int
 		// Duration Returns the duration of this period.
 		Duration() time.Duration
 		// Overlaps Returns the overlap period between the two time periods, and whether it overlaps or not.
@@ -62,6 +67,14 @@ func Must(startTime, endTime *time.Time) TimePeriod {
 	return period
 }
 
+// Compare Returns -1, 0 or +1 depending on whether this period is before, equal to or after the other one.
+func (tp startTimeEndTimePeriod) Compare(other TimePeriod) int {
+	return cmp.Or(
+		compareTimes(tp.startTime, other.StartTime(), -1),
+		compareTimes(tp.endTime, other.EndTime(), +1),
+	)
+}
+
 // Duration Returns the duration of this period.
 func (tp startTimeEndTimePeriod) Duration() time.Duration {
 	if tp.startTime == nil || tp.endTime == nil {
@@ -135,3 +148,17 @@ func (tp startTimeEndTimePeriod) intersect(comparePeriod TimePeriod) TimePeriod
 		endTime:   end,
 	}
 }
+
+// compareTimes compares two optional times, nil being before (nilOrder -1) or after (nilOrder +1) any other time.
+func compareTimes(a, b *time.Time, nilOrder int) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return nilOrder
+	case b == nil:
+		return -nilOrder
+	default:
+		return a.Compare(*b)
+	}
+}
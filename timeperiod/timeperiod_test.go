@@ -2,6 +2,7 @@ package timeperiod
 
 import (
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -737,6 +738,87 @@ func TestIntersect(t *testing.T) {
 	}
 }
 
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	noon := ptr(time.Date(2022, time.January, 1, 12, 0, 0, 0, time.UTC))
+	afternoon := ptr(time.Date(2022, time.January, 1, 13, 0, 0, 0, time.UTC))
+	evening := ptr(time.Date(2022, time.January, 1, 20, 0, 0, 0, time.UTC))
+
+	tests := map[string]struct {
+		a, b TimePeriod
+		want int
+	}{
+		"Same period": {
+			a:    Must(noon, afternoon),
+			b:    Must(ptr(*noon), ptr(*afternoon)),
+			want: 0,
+		},
+		"Earlier start": {
+			a:    Must(noon, evening),
+			b:    Must(afternoon, evening),
+			want: -1,
+		},
+		"Same start, earlier end": {
+			a:    Must(noon, afternoon),
+			b:    Must(noon, evening),
+			want: -1,
+		},
+		"No start is before any start": {
+			a:    Must(nil, evening),
+			b:    Must(noon, afternoon),
+			want: -1,
+		},
+		"No end is after any end": {
+			a:    Must(noon, nil),
+			b:    Must(noon, evening),
+			want: 1,
+		},
+		"Infinite": {
+			a:    Infinite,
+			b:    Must(nil, nil),
+			want: 0,
+		},
+		"Infinite is before periods with start": {
+			a:    Infinite,
+			b:    Must(noon, nil),
+			want: -1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.want {
+				t.Errorf("Compare() = %v, want %v", got, test.want)
+			}
+
+			if got := test.b.Compare(test.a); got != -test.want {
+				t.Errorf("reversed Compare() = %v, want %v", got, -test.want)
+			}
+		})
+	}
+}
+
+func TestSortByCompare(t *testing.T) {
+	t.Parallel()
+
+	noon := ptr(time.Date(2022, time.January, 1, 12, 0, 0, 0, time.UTC))
+	evening := ptr(time.Date(2022, time.January, 1, 20, 0, 0, 0, time.UTC))
+
+	periods := []TimePeriod{Must(noon, nil), Must(noon, evening), Infinite, Must(nil, noon)}
+	want := []TimePeriod{Must(nil, noon), Infinite, Must(noon, evening), Must(noon, nil)}
+
+	slices.SortFunc(periods, TimePeriod.Compare)
+
+	for i := range want {
+		if periods[i].Compare(want[i]) != 0 {
+			t.Errorf("SortFunc()[%d] = %v, want %v", i, periods[i], want[i])
+		}
+	}
+}
+
 func TestNew(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,36 @@
+package yearmonth
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = YearMonth{}
+	_ encoding.TextUnmarshaler = (*YearMonth)(nil)
+	_ json.Marshaler           = YearMonth{}
+	_ json.Unmarshaler         = (*YearMonth)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the YearMonth as an ISO-8601 string, e.g. "2024-02".
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	return codec.MarshalJSON(len("+YYYYY-MM"), ym.appendFormat), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the YearMonth in the ISO-8601 format.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return ym.appendFormat(make([]byte, 0, len("+YYYYY-MM"))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the formats supported by Parse.
+// A JSON null leaves the YearMonth unchanged.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "yearmonth: YearMonth", ym.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the formats supported by Parse.
+func (ym *YearMonth) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(ym, data, Parse)
+}
@@ -0,0 +1,53 @@
+package yearmonth
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type card struct {
+	Expiry  YearMonth  `json:"expiry"`
+	Renewed *YearMonth `json:"renewed"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	value := card{Expiry: New(2027, time.March)}
+	want := `{"expiry":"2027-03","renewed":null}`
+
+	data, err := json.Marshal(value)
+	if err != nil || string(data) != want {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, want)
+	}
+
+	var got card
+	if err = json.Unmarshal(data, &got); err != nil || got != value {
+		t.Errorf("json.Unmarshal = %+v, %v, want %+v", got, err, value)
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"expiry":202703}`, `{"expiry":"2027-13"}`} {
+		var got card
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, want error", data, got)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	var got YearMonth
+	if err := got.UnmarshalText([]byte("2024-02")); err != nil || got != New(2024, time.February) {
+		t.Errorf("UnmarshalText = %v, %v, want 2024-02", got, err)
+	}
+
+	if text, err := got.MarshalText(); err != nil || string(text) != "2024-02" {
+		t.Errorf("MarshalText = %q, %v, want 2024-02", text, err)
+	}
+}
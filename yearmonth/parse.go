@@ -0,0 +1,59 @@
+package yearmonth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+// ErrSyntax indicates that a value is not an ISO-8601 year and month, e.g. 2024-02.
+var ErrSyntax = errors.New("invalid ISO-8601 year-month syntax")
+
+// ParseError describes a problem parsing a YearMonth.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure, either ErrSyntax or a *RangeError.
+	Err error
+}
+
+// Parse a YearMonth from the ISO-8601 format YYYY-MM, e.g. 2024-02, or with an expanded year, e.g. +12024-02.
+// Returns a *ParseError if the value can't be parsed.
+func Parse(s string) (YearMonth, error) {
+	ym, err := parse(s)
+	if err != nil {
+		return YearMonth{}, &ParseError{Value: s, Err: err}
+	}
+
+	return ym, nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("yearmonth: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parse(s string) (YearMonth, error) {
+	i := strings.LastIndexByte(s, '-')
+	if i <= 0 || len(s)-i != len("-MM") {
+		return YearMonth{}, ErrSyntax
+	}
+
+	year, ok := codec.ParseYear(s[:i])
+	if !ok {
+		return YearMonth{}, ErrSyntax
+	}
+
+	month, ok := codec.Atoi(s[i+1:])
+	if !ok {
+		return YearMonth{}, ErrSyntax
+	}
+
+	return Of(year, time.Month(month))
+}
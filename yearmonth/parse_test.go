@@ -0,0 +1,86 @@
+package yearmonth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  YearMonth
+	}{
+		"Year and month":         {value: "2024-02", want: New(2024, time.February)},
+		"Expanded positive year": {value: "+12024-12", want: New(12024, time.December)},
+		"Expanded negative year": {value: "-0001-01", want: New(-1, time.January)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil || got != test.want {
+				t.Fatalf("Parse = %v, %v, want %v", got, err, test.want)
+			}
+
+			if got.String() != test.value {
+				t.Errorf("String = %q, want %q", got.String(), test.value)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     string
+		want      error
+		wantRange *RangeError
+	}{
+		"Empty":              {value: "", want: ErrSyntax},
+		"Full date":          {value: "2024-02-29", want: ErrSyntax},
+		"Single digit month": {value: "2024-2", want: ErrSyntax},
+		"Two digit year":     {value: "24-02", want: ErrSyntax},
+		"Basic format":       {value: "202402", want: ErrSyntax},
+		"Letters":            {value: "2024-FE", want: ErrSyntax},
+		"Month 13": {
+			value:     "2024-13",
+			wantRange: &RangeError{Field: "month", Value: 13, Min: 1, Max: 12},
+		},
+		"Month 0": {
+			value:     "2024-00",
+			wantRange: &RangeError{Field: "month", Value: 0, Min: 1, Max: 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse error = %v, want *ParseError", err)
+			}
+
+			if test.wantRange != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || *rangeErr != *test.wantRange {
+					t.Errorf("Parse error = %v, want %v", err, test.wantRange)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Parse error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
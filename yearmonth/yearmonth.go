@@ -0,0 +1,176 @@
+// Package yearmonth provides YearMonth, storing a year and a month, timezone independent.
+// Same concept as https://docs.oracle.com/javase/8/docs/api/java/time/YearMonth.html.
+package yearmonth
+
+import (
+	"cmp"
+	"fmt"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/internal/codec"
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+var _ fmt.Stringer = YearMonth{}
+
+type (
+	// YearMonth is a month of a year without a day or time-zone, e.g. 2024-02, as used for billing months or
+	// credit card expiry dates.
+	// It is a comparable value, so it can be used with == and as a map key.
+	// The zero value is January of year 1.
+	YearMonth struct {
+		// The fields are stored as offsets from January, year 1, so the zero value is a valid month.
+		year  int
+		month time.Month
+	}
+
+	// RangeError is returned by Of when the month is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, "month".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
+)
+
+// New YearMonth from year and month.
+// The values are not validated, use Of to reject months like 13, or Normalize to roll them over.
+func New(year int, month time.Month) YearMonth {
+	return YearMonth{
+		year:  year - 1,
+		month: month - time.January,
+	}
+}
+
+// Of YearMonth from year and month.
+// Returns a *RangeError if the month is not in [1, 12].
+func Of(year int, month time.Month) (YearMonth, error) {
+	if month < time.January || month > time.December {
+		return YearMonth{}, &RangeError{Field: "month", Value: int(month), Min: int(time.January), Max: int(time.December)}
+	}
+
+	return New(year, month), nil
+}
+
+// Normalize YearMonth from year and month, rolling out of range months over the same way time.Date does,
+// e.g. month 13 of 2024 is January 2025.
+func Normalize(year int, month time.Month) YearMonth {
+	total := year*12 + int(month-time.January)
+	y := arith.FloorDiv(total, 12)
+
+	return New(y, time.Month(total-y*12)+time.January)
+}
+
+// FromLocalDate returns the YearMonth of the LocalDate.
+func FromLocalDate(ld localdate.LocalDate) YearMonth {
+	return New(ld.Year(), ld.Month())
+}
+
+// FromTime returns the YearMonth of the time.Time, in its location.
+func FromTime(t time.Time) YearMonth {
+	return New(t.Year(), t.Month())
+}
+
+// After reports whether the YearMonth is after the given other YearMonth.
+func (ym YearMonth) After(other YearMonth) bool {
+	return ym.Compare(other) > 0
+}
+
+// AtDay returns the LocalDate of the given day of the YearMonth.
+// Returns a *localdate.RangeError if the day does not exist in the month.
+func (ym YearMonth) AtDay(day int) (localdate.LocalDate, error) {
+	return localdate.Of(ym.Year(), ym.Month(), day)
+}
+
+// AtEndOfMonth returns the LocalDate of the last day of the YearMonth.
+func (ym YearMonth) AtEndOfMonth() localdate.LocalDate {
+	return localdate.New(ym.Year(), ym.Month(), ym.LengthOfMonth())
+}
+
+// Before reports whether the YearMonth is before the given other YearMonth.
+func (ym YearMonth) Before(other YearMonth) bool {
+	return ym.Compare(other) < 0
+}
+
+// Compare returns -1 if the YearMonth is before other, 0 if they are equal, and +1 if it's after.
+func (ym YearMonth) Compare(other YearMonth) int {
+	if ym.year != other.year {
+		return cmp.Compare(ym.year, other.year)
+	}
+
+	return cmp.Compare(ym.month, other.month)
+}
+
+// Equal reports whether the YearMonth is equal to the given other YearMonth.
+// It's the same as using ==.
+func (ym YearMonth) Equal(other YearMonth) bool {
+	return ym == other
+}
+
+// IsLeapYear reports whether the year of the YearMonth is a leap year.
+func (ym YearMonth) IsLeapYear() bool {
+	return ym.AtEndOfMonth().IsLeapYear()
+}
+
+// IsZero reports whether the YearMonth is the zero value, January of year 1.
+func (ym YearMonth) IsZero() bool {
+	return ym == YearMonth{}
+}
+
+// LengthOfMonth returns the number of days in the month, from 28 to 31.
+func (ym YearMonth) LengthOfMonth() int {
+	return localdate.New(ym.Year(), ym.Month(), 1).LengthOfMonth()
+}
+
+// MinusMonths returns a copy of the YearMonth with the given number of months subtracted.
+func (ym YearMonth) MinusMonths(months int) YearMonth {
+	return ym.PlusMonths(-months)
+}
+
+// MinusYears returns a copy of the YearMonth with the given number of years subtracted.
+func (ym YearMonth) MinusYears(years int) YearMonth {
+	return ym.PlusYears(-years)
+}
+
+func (ym YearMonth) Month() time.Month {
+	return ym.month + time.January
+}
+
+// MonthsUntil returns the number of months from the YearMonth to end, negative if end is before it.
+func (ym YearMonth) MonthsUntil(end YearMonth) int {
+	return (end.year-ym.year)*12 + int(end.month-ym.month)
+}
+
+// PlusMonths returns a copy of the YearMonth with the given number of months added.
+func (ym YearMonth) PlusMonths(months int) YearMonth {
+	return Normalize(ym.Year(), ym.Month()+time.Month(months))
+}
+
+// PlusYears returns a copy of the YearMonth with the given number of years added.
+func (ym YearMonth) PlusYears(years int) YearMonth {
+	return New(ym.Year()+years, ym.Month())
+}
+
+// String returns the YearMonth in the ISO-8601 format, e.g. 2024-02.
+// Years outside [0, 9999] are prefixed with their sign, e.g. +12024-01.
+func (ym YearMonth) String() string {
+	return string(ym.appendFormat(make([]byte, 0, len("+YYYYY-MM"))))
+}
+
+func (ym YearMonth) Year() int {
+	return ym.year + 1
+}
+
+func (ym YearMonth) appendFormat(b []byte) []byte {
+	b = codec.AppendYear(b, ym.Year())
+	b = append(b, '-')
+
+	return codec.AppendInt(b, int(ym.Month()), 2)
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("yearmonth: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
@@ -0,0 +1,240 @@
+package yearmonth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	ym := New(2024, time.February)
+	if ym.Year() != 2024 || ym.Month() != time.February {
+		t.Errorf("New = %v, want 2024-02", ym)
+	}
+
+	if got := New(2024, 13).Month(); got != 13 {
+		t.Errorf("New(2024, 13).Month() = %d, want 13 as it is not validated", got)
+	}
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year    int
+		month   time.Month
+		wantErr *RangeError
+	}{
+		"Valid month": {
+			year:  2024,
+			month: time.December,
+		},
+		"Month zero": {
+			year:    2024,
+			month:   0,
+			wantErr: &RangeError{Field: "month", Value: 0, Min: 1, Max: 12},
+		},
+		"Month 13": {
+			year:    2024,
+			month:   13,
+			wantErr: &RangeError{Field: "month", Value: 13, Min: 1, Max: 12},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.year, test.month)
+			if test.wantErr != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("Of: expected *RangeError, got %v", err)
+				}
+
+				if *rangeErr != *test.wantErr {
+					t.Errorf("Of: error = %+v, want %+v", rangeErr, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Of: unexpected error %v", err)
+			}
+
+			if got != New(test.year, test.month) {
+				t.Errorf("Of = %v, want %d-%d", got, test.year, test.month)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year  int
+		month time.Month
+		want  YearMonth
+	}{
+		"Regular month":       {year: 2024, month: time.February, want: New(2024, time.February)},
+		"Month 13 rolls over": {year: 2024, month: 13, want: New(2025, time.January)},
+		"Month 0 rolls back":  {year: 2024, month: 0, want: New(2023, time.December)},
+		"Negative year":       {year: -1, month: -1, want: New(-2, time.November)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Normalize(test.year, test.month); got != test.want {
+				t.Errorf("Normalize = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+
+	ym := New(2024, time.November)
+
+	if got, want := ym.PlusMonths(3), New(2025, time.February); got != want {
+		t.Errorf("PlusMonths = %v, want %v", got, want)
+	}
+
+	if got, want := ym.MinusMonths(11), New(2023, time.December); got != want {
+		t.Errorf("MinusMonths = %v, want %v", got, want)
+	}
+
+	if got, want := ym.PlusYears(2), New(2026, time.November); got != want {
+		t.Errorf("PlusYears = %v, want %v", got, want)
+	}
+
+	if got, want := ym.MinusYears(2024), New(0, time.November); got != want {
+		t.Errorf("MinusYears = %v, want %v", got, want)
+	}
+
+	if got := ym.MonthsUntil(New(2026, time.January)); got != 14 {
+		t.Errorf("MonthsUntil = %d, want 14", got)
+	}
+
+	if got := ym.MonthsUntil(New(2024, time.January)); got != -10 {
+		t.Errorf("MonthsUntil = %d, want -10", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b YearMonth
+		want int
+	}{
+		"Before, same year":      {a: New(2024, time.January), b: New(2024, time.February), want: -1},
+		"Before, different year": {a: New(2023, time.December), b: New(2024, time.January), want: -1},
+		"Equal":                  {a: New(2024, time.February), b: New(2024, time.February), want: 0},
+		"After":                  {a: New(2025, time.January), b: New(2024, time.December), want: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.want {
+				t.Errorf("Compare = %d, want %d", got, test.want)
+			}
+
+			if got := test.a.Before(test.b); got != (test.want < 0) {
+				t.Errorf("Before = %v, want %v", got, test.want < 0)
+			}
+
+			if got := test.a.After(test.b); got != (test.want > 0) {
+				t.Errorf("After = %v, want %v", got, test.want > 0)
+			}
+
+			if got := test.a.Equal(test.b); got != (test.want == 0) {
+				t.Errorf("Equal = %v, want %v", got, test.want == 0)
+			}
+		})
+	}
+}
+
+func TestDays(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		ym            YearMonth
+		lengthOfMonth int
+		leapYear      bool
+		endOfMonth    localdate.LocalDate
+	}{
+		"Leap February": {
+			ym:            New(2024, time.February),
+			lengthOfMonth: 29,
+			leapYear:      true,
+			endOfMonth:    localdate.New(2024, time.February, 29),
+		},
+		"Non leap February": {
+			ym:            New(2023, time.February),
+			lengthOfMonth: 28,
+			endOfMonth:    localdate.New(2023, time.February, 28),
+		},
+		"April": {
+			ym:            New(2023, time.April),
+			lengthOfMonth: 30,
+			endOfMonth:    localdate.New(2023, time.April, 30),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.ym.LengthOfMonth(); got != test.lengthOfMonth {
+				t.Errorf("LengthOfMonth = %d, want %d", got, test.lengthOfMonth)
+			}
+
+			if got := test.ym.IsLeapYear(); got != test.leapYear {
+				t.Errorf("IsLeapYear = %v, want %v", got, test.leapYear)
+			}
+
+			if got := test.ym.AtEndOfMonth(); got != test.endOfMonth {
+				t.Errorf("AtEndOfMonth = %v, want %v", got, test.endOfMonth)
+			}
+
+			if got, err := test.ym.AtDay(test.lengthOfMonth); err != nil || got != test.endOfMonth {
+				t.Errorf("AtDay = %v, %v, want %v", got, err, test.endOfMonth)
+			}
+
+			var rangeErr *localdate.RangeError
+			if _, err := test.ym.AtDay(test.lengthOfMonth + 1); !errors.As(err, &rangeErr) {
+				t.Errorf("AtDay = %v, want *localdate.RangeError", err)
+			}
+		})
+	}
+}
+
+func TestConversions(t *testing.T) {
+	t.Parallel()
+
+	want := New(2024, time.February)
+
+	if got := FromLocalDate(localdate.New(2024, time.February, 29)); got != want {
+		t.Errorf("FromLocalDate = %v, want %v", got, want)
+	}
+
+	if got := FromTime(time.Date(2024, time.February, 29, 23, 0, 0, 0, time.UTC)); got != want {
+		t.Errorf("FromTime = %v, want %v", got, want)
+	}
+
+	var zero YearMonth
+	if !zero.IsZero() || zero.String() != "0001-01" {
+		t.Errorf("zero value = %v, want 0001-01", zero)
+	}
+}
@@ -0,0 +1,36 @@
+package yearquarter
+
+import (
+	"encoding"
+	"encoding/json"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+var (
+	_ encoding.TextMarshaler   = YearQuarter{}
+	_ encoding.TextUnmarshaler = (*YearQuarter)(nil)
+	_ json.Marshaler           = YearQuarter{}
+	_ json.Unmarshaler         = (*YearQuarter)(nil)
+)
+
+// MarshalJSON implements json.Marshaler, encoding the YearQuarter as a string, e.g. "2024-Q3".
+func (yq YearQuarter) MarshalJSON() ([]byte, error) {
+	return codec.MarshalJSON(len("+YYYYY-Qq"), yq.appendFormat), nil
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the YearQuarter in the format YYYY-Qq.
+func (yq YearQuarter) MarshalText() ([]byte, error) {
+	return yq.appendFormat(make([]byte, 0, len("+YYYYY-Qq"))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accepting the formats supported by Parse.
+// A JSON null leaves the YearQuarter unchanged.
+func (yq *YearQuarter) UnmarshalJSON(data []byte) error {
+	return codec.UnmarshalJSON(data, "yearquarter: YearQuarter", yq.UnmarshalText)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the formats supported by Parse.
+func (yq *YearQuarter) UnmarshalText(data []byte) error {
+	return codec.UnmarshalText(yq, data, Parse)
+}
@@ -0,0 +1,52 @@
+package yearquarter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type report struct {
+	Quarter  YearQuarter  `json:"quarter"`
+	Restated *YearQuarter `json:"restated"`
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	value := report{Quarter: New(2024, 3)}
+	want := `{"quarter":"2024-Q3","restated":null}`
+
+	data, err := json.Marshal(value)
+	if err != nil || string(data) != want {
+		t.Fatalf("json.Marshal = %s, %v, want %s", data, err, want)
+	}
+
+	var got report
+	if err = json.Unmarshal(data, &got); err != nil || got != value {
+		t.Errorf("json.Unmarshal = %+v, %v, want %+v", got, err, value)
+	}
+}
+
+func TestUnmarshalJSONError(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"quarter":3}`, `{"quarter":"2024-Q5"}`} {
+		var got report
+		if err := json.Unmarshal([]byte(data), &got); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %+v, want error", data, got)
+		}
+	}
+}
+
+func TestText(t *testing.T) {
+	t.Parallel()
+
+	var got YearQuarter
+	if err := got.UnmarshalText([]byte("2024-Q1")); err != nil || got != New(2024, 1) {
+		t.Errorf("UnmarshalText = %v, %v, want 2024-Q1", got, err)
+	}
+
+	if text, err := got.MarshalText(); err != nil || string(text) != "2024-Q1" {
+		t.Errorf("MarshalText = %q, %v, want 2024-Q1", text, err)
+	}
+}
@@ -0,0 +1,58 @@
+package yearquarter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/manuelarte/gotimeplus/internal/codec"
+)
+
+// ErrSyntax indicates that a value is not a year and quarter, e.g. 2024-Q3.
+var ErrSyntax = errors.New("invalid year-quarter syntax")
+
+// ParseError describes a problem parsing a YearQuarter.
+type ParseError struct {
+	// Value is the text being parsed.
+	Value string
+	// Err is the reason of the failure, either ErrSyntax or a *RangeError.
+	Err error
+}
+
+// Parse a YearQuarter from the format YYYY-Qq, e.g. 2024-Q3, or with an expanded year, e.g. +12024-Q1.
+// Returns a *ParseError if the value can't be parsed.
+func Parse(s string) (YearQuarter, error) {
+	yq, err := parse(s)
+	if err != nil {
+		return YearQuarter{}, &ParseError{Value: s, Err: err}
+	}
+
+	return yq, nil
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("yearquarter: parsing %q: %v", e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+func parse(s string) (YearQuarter, error) {
+	i := strings.LastIndex(s, "-Q")
+	if i <= 0 || len(s)-i != len("-Qq") {
+		return YearQuarter{}, ErrSyntax
+	}
+
+	year, ok := codec.ParseYear(s[:i])
+	if !ok {
+		return YearQuarter{}, ErrSyntax
+	}
+
+	quarter, ok := codec.Atoi(s[i+2:])
+	if !ok {
+		return YearQuarter{}, ErrSyntax
+	}
+
+	return Of(year, quarter)
+}
@@ -0,0 +1,86 @@
+package yearquarter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value string
+		want  YearQuarter
+	}{
+		"Year and quarter":       {value: "2024-Q3", want: New(2024, 3)},
+		"Expanded positive year": {value: "+12024-Q4", want: New(12024, 4)},
+		"Expanded negative year": {value: "-0001-Q1", want: New(-1, 1)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(test.value)
+			if err != nil || got != test.want {
+				t.Fatalf("Parse = %v, %v, want %v", got, err, test.want)
+			}
+
+			if got.String() != test.value {
+				t.Errorf("String = %q, want %q", got.String(), test.value)
+			}
+		})
+	}
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value     string
+		want      error
+		wantRange *RangeError
+	}{
+		"Empty":             {value: "", want: ErrSyntax},
+		"Year month":        {value: "2024-03", want: ErrSyntax},
+		"Lowercase q":       {value: "2024-q3", want: ErrSyntax},
+		"Two digit year":    {value: "24-Q3", want: ErrSyntax},
+		"Missing dash":      {value: "2024Q3", want: ErrSyntax},
+		"Letter quarter":    {value: "2024-QX", want: ErrSyntax},
+		"Two digit quarter": {value: "2024-Q03", want: ErrSyntax},
+		"Quarter 5": {
+			value:     "2024-Q5",
+			wantRange: &RangeError{Field: "quarter", Value: 5, Min: 1, Max: 4},
+		},
+		"Quarter 0": {
+			value:     "2024-Q0",
+			wantRange: &RangeError{Field: "quarter", Value: 0, Min: 1, Max: 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(test.value)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse error = %v, want *ParseError", err)
+			}
+
+			if test.wantRange != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) || *rangeErr != *test.wantRange {
+					t.Errorf("Parse error = %v, want %v", err, test.wantRange)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, test.want) {
+				t.Errorf("Parse error = %v, want %v", err, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+// Package yearquarter provides YearQuarter, storing a year and a quarter of that year, timezone independent.
+// Same concept as https://www.threeten.org/threeten-extra/apidocs/org.threeten.extra/org/threeten/extra/YearQuarter.html.
+package yearquarter
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/internal/arith"
+	"github.com/manuelarte/gotimeplus/internal/codec"
+	"github.com/manuelarte/gotimeplus/localdate"
+	"github.com/manuelarte/gotimeplus/timeperiod"
+)
+
+var _ fmt.Stringer = YearQuarter{}
+
+type (
+	// YearQuarter is a quarter of a year without a day or time-zone, e.g. 2024-Q3, as used for financial reporting.
+	// Quarters are calendar quarters, Q1 is January to March, Q2 April to June, Q3 July to September and Q4 October
+	// to December.
+	// It is a comparable value, so it can be used with == and as a map key.
+	// The zero value is the first quarter of year 1.
+	YearQuarter struct {
+		// The fields are stored as offsets from Q1, year 1, so the zero value is a valid quarter.
+		year    int
+		quarter int
+	}
+
+	// RangeError is returned by Of when the quarter is outside its allowed range.
+	RangeError struct {
+		// Field is the name of the offending field, "quarter".
+		Field string
+		// Value is the rejected value.
+		Value int
+		// Min and Max are the inclusive bounds allowed for Field.
+		Min, Max int
+	}
+)
+
+// New YearQuarter from year and quarter.
+// The values are not validated, use Of to reject quarters like 5, or Normalize to roll them over.
+func New(year, quarter int) YearQuarter {
+	return YearQuarter{
+		year:    year - 1,
+		quarter: quarter - 1,
+	}
+}
+
+// Of YearQuarter from year and quarter.
+// Returns a *RangeError if the quarter is not in [1, 4].
+func Of(year, quarter int) (YearQuarter, error) {
+	if quarter < 1 || quarter > 4 {
+		return YearQuarter{}, &RangeError{Field: "quarter", Value: quarter, Min: 1, Max: 4}
+	}
+
+	return New(year, quarter), nil
+}
+
+// Normalize YearQuarter from year and quarter, rolling out of range quarters over, e.g. quarter 5 of 2024 is the
+// first quarter of 2025.
+func Normalize(year, quarter int) YearQuarter {
+	total := year*4 + quarter - 1
+	y := arith.FloorDiv(total, 4)
+
+	return New(y, total-y*4+1)
+}
+
+// FromLocalDate returns the YearQuarter of the LocalDate.
+func FromLocalDate(ld localdate.LocalDate) YearQuarter {
+	return New(ld.Year(), ld.Quarter())
+}
+
+// FromTime returns the YearQuarter of the time.Time, in its location.
+func FromTime(t time.Time) YearQuarter {
+	return FromLocalDate(localdate.FromTime(t))
+}
+
+// After reports whether the YearQuarter is after the given other YearQuarter.
+func (yq YearQuarter) After(other YearQuarter) bool {
+	return yq.Compare(other) > 0
+}
+
+// Before reports whether the YearQuarter is before the given other YearQuarter.
+func (yq YearQuarter) Before(other YearQuarter) bool {
+	return yq.Compare(other) < 0
+}
+
+// Compare returns -1 if the YearQuarter is before other, 0 if they are equal, and +1 if it's after.
+func (yq YearQuarter) Compare(other YearQuarter) int {
+	if yq.year != other.year {
+		return cmp.Compare(yq.year, other.year)
+	}
+
+	return cmp.Compare(yq.quarter, other.quarter)
+}
+
+// Equal reports whether the YearQuarter is equal to the given other YearQuarter.
+// It's the same as using ==.
+func (yq YearQuarter) Equal(other YearQuarter) bool {
+	return yq == other
+}
+
+// FirstDay returns the LocalDate of the first day of the quarter, e.g. 2024-07-01 for 2024-Q3.
+func (yq YearQuarter) FirstDay() localdate.LocalDate {
+	return localdate.New(yq.Year(), yq.FirstMonth(), 1)
+}
+
+// FirstMonth returns the first month of the quarter, e.g. July for Q3.
+func (yq YearQuarter) FirstMonth() time.Month {
+	return time.Month(yq.quarter*3) + time.January
+}
+
+// IsZero reports whether the YearQuarter is the zero value, the first quarter of year 1.
+func (yq YearQuarter) IsZero() bool {
+	return yq == YearQuarter{}
+}
+
+// LastDay returns the LocalDate of the last day of the quarter, e.g. 2024-09-30 for 2024-Q3.
+func (yq YearQuarter) LastDay() localdate.LocalDate {
+	return yq.FirstDay().PlusMonths(3).MinusDays(1)
+}
+
+// LengthOfQuarter returns the number of days in the quarter, from 90 to 92.
+func (yq YearQuarter) LengthOfQuarter() int {
+	return int(localdate.DaysBetween(yq.FirstDay(), yq.LastDay())) + 1
+}
+
+// MinusQuarters returns a copy of the YearQuarter with the given number of quarters subtracted.
+func (yq YearQuarter) MinusQuarters(quarters int) YearQuarter {
+	return yq.PlusQuarters(-quarters)
+}
+
+// MinusYears returns a copy of the YearQuarter with the given number of years subtracted.
+func (yq YearQuarter) MinusYears(years int) YearQuarter {
+	return yq.PlusYears(-years)
+}
+
+// PlusQuarters returns a copy of the YearQuarter with the given number of quarters added.
+func (yq YearQuarter) PlusQuarters(quarters int) YearQuarter {
+	return Normalize(yq.Year(), yq.Quarter()+quarters)
+}
+
+// PlusYears returns a copy of the YearQuarter with the given number of years added.
+func (yq YearQuarter) PlusYears(years int) YearQuarter {
+	return New(yq.Year()+years, yq.Quarter())
+}
+
+// Quarter returns the quarter of the year, from 1 to 4.
+func (yq YearQuarter) Quarter() int {
+	return yq.quarter + 1
+}
+
+// QuartersUntil returns the number of quarters from the YearQuarter to end, negative if end is before it.
+func (yq YearQuarter) QuartersUntil(end YearQuarter) int {
+	return (end.year-yq.year)*4 + end.quarter - yq.quarter
+}
+
+// String returns the YearQuarter in the format YYYY-Qq, e.g. 2024-Q3.
+// Years outside [0, 9999] are prefixed with their sign, e.g. +12024-Q1.
+func (yq YearQuarter) String() string {
+	return string(yq.appendFormat(make([]byte, 0, len("+YYYYY-Qq"))))
+}
+
+// ToTimePeriod returns the TimePeriod covering the quarter in the given location, from midnight of its first day
+// until midnight of the first day of the next quarter.
+func (yq YearQuarter) ToTimePeriod(loc *time.Location) timeperiod.TimePeriod {
+	start := yq.FirstDay().ToTime(loc)
+	end := yq.PlusQuarters(1).FirstDay().ToTime(loc)
+
+	return timeperiod.Must(&start, &end)
+}
+
+func (yq YearQuarter) Year() int {
+	return yq.year + 1
+}
+
+func (yq YearQuarter) appendFormat(b []byte) []byte {
+	b = codec.AppendYear(b, yq.Year())
+	b = append(b, '-', 'Q')
+
+	return strconv.AppendInt(b, int64(yq.Quarter()), 10)
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("yearquarter: %s %d out of range [%d, %d]", e.Field, e.Value, e.Min, e.Max)
+}
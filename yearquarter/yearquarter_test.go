@@ -0,0 +1,309 @@
+package yearquarter
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/manuelarte/gotimeplus/localdate"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	yq := New(2024, 3)
+	if yq.Year() != 2024 || yq.Quarter() != 3 {
+		t.Errorf("New = %v, want 2024-Q3", yq)
+	}
+
+	if got := New(2024, 5).Quarter(); got != 5 {
+		t.Errorf("New(2024, 5).Quarter() = %d, want 5 as it is not validated", got)
+	}
+}
+
+func TestOf(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year    int
+		quarter int
+		wantErr *RangeError
+	}{
+		"Valid quarter": {
+			year:    2024,
+			quarter: 4,
+		},
+		"Quarter zero": {
+			year:    2024,
+			quarter: 0,
+			wantErr: &RangeError{Field: "quarter", Value: 0, Min: 1, Max: 4},
+		},
+		"Quarter 5": {
+			year:    2024,
+			quarter: 5,
+			wantErr: &RangeError{Field: "quarter", Value: 5, Min: 1, Max: 4},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Of(test.year, test.quarter)
+			if test.wantErr != nil {
+				var rangeErr *RangeError
+				if !errors.As(err, &rangeErr) {
+					t.Fatalf("Of: expected *RangeError, got %v", err)
+				}
+
+				if *rangeErr != *test.wantErr {
+					t.Errorf("Of: error = %+v, want %+v", rangeErr, test.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Of: unexpected error %v", err)
+			}
+
+			if got != New(test.year, test.quarter) {
+				t.Errorf("Of = %v, want %d-Q%d", got, test.year, test.quarter)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		year        int
+		quarter     int
+		wantYear    int
+		wantQuarter int
+	}{
+		"Regular quarter":       {year: 2024, quarter: 3, wantYear: 2024, wantQuarter: 3},
+		"Quarter 5 rolls over":  {year: 2024, quarter: 5, wantYear: 2025, wantQuarter: 1},
+		"Quarter 0 rolls back":  {year: 2024, quarter: 0, wantYear: 2023, wantQuarter: 4},
+		"Negative quarter":      {year: 2024, quarter: -4, wantYear: 2022, wantQuarter: 4},
+		"Negative year":         {year: -1, quarter: 1, wantYear: -1, wantQuarter: 1},
+		"Quarter 9 rolls twice": {year: 2024, quarter: 9, wantYear: 2026, wantQuarter: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Normalize(test.year, test.quarter)
+			if got.Year() != test.wantYear || got.Quarter() != test.wantQuarter {
+				t.Errorf("Normalize = %v, want %d-Q%d", got, test.wantYear, test.wantQuarter)
+			}
+		})
+	}
+}
+
+func TestDays(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		yq         YearQuarter
+		firstMonth time.Month
+		firstDay   localdate.LocalDate
+		lastDay    localdate.LocalDate
+		length     int
+	}{
+		"Q1 leap year": {
+			yq:         New(2024, 1),
+			firstMonth: time.January,
+			firstDay:   localdate.New(2024, time.January, 1),
+			lastDay:    localdate.New(2024, time.March, 31),
+			length:     91,
+		},
+		"Q1 non leap year": {
+			yq:         New(2023, 1),
+			firstMonth: time.January,
+			firstDay:   localdate.New(2023, time.January, 1),
+			lastDay:    localdate.New(2023, time.March, 31),
+			length:     90,
+		},
+		"Q2": {
+			yq:         New(2024, 2),
+			firstMonth: time.April,
+			firstDay:   localdate.New(2024, time.April, 1),
+			lastDay:    localdate.New(2024, time.June, 30),
+			length:     91,
+		},
+		"Q3": {
+			yq:         New(2024, 3),
+			firstMonth: time.July,
+			firstDay:   localdate.New(2024, time.July, 1),
+			lastDay:    localdate.New(2024, time.September, 30),
+			length:     92,
+		},
+		"Q4": {
+			yq:         New(2024, 4),
+			firstMonth: time.October,
+			firstDay:   localdate.New(2024, time.October, 1),
+			lastDay:    localdate.New(2024, time.December, 31),
+			length:     92,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.yq.FirstMonth(); got != test.firstMonth {
+				t.Errorf("FirstMonth = %v, want %v", got, test.firstMonth)
+			}
+
+			if got := test.yq.FirstDay(); got != test.firstDay {
+				t.Errorf("FirstDay = %v, want %v", got, test.firstDay)
+			}
+
+			if got := test.yq.LastDay(); got != test.lastDay {
+				t.Errorf("LastDay = %v, want %v", got, test.lastDay)
+			}
+
+			if got := test.yq.LengthOfQuarter(); got != test.length {
+				t.Errorf("LengthOfQuarter = %d, want %d", got, test.length)
+			}
+
+			if got := FromLocalDate(test.lastDay); got != test.yq {
+				t.Errorf("FromLocalDate = %v, want %v", got, test.yq)
+			}
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+
+	yq := New(2024, 3)
+
+	if got, want := yq.PlusQuarters(2), New(2025, 1); got != want {
+		t.Errorf("PlusQuarters = %v, want %v", got, want)
+	}
+
+	if got, want := yq.MinusQuarters(3), New(2023, 4); got != want {
+		t.Errorf("MinusQuarters = %v, want %v", got, want)
+	}
+
+	if got, want := yq.PlusYears(1), New(2025, 3); got != want {
+		t.Errorf("PlusYears = %v, want %v", got, want)
+	}
+
+	if got, want := yq.MinusYears(1), New(2023, 3); got != want {
+		t.Errorf("MinusYears = %v, want %v", got, want)
+	}
+
+	if got := yq.QuartersUntil(New(2026, 1)); got != 6 {
+		t.Errorf("QuartersUntil = %d, want 6", got)
+	}
+
+	if got := yq.QuartersUntil(New(2024, 1)); got != -2 {
+		t.Errorf("QuartersUntil = %d, want -2", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		a, b YearQuarter
+		want int
+	}{
+		"Before, same year":      {a: New(2024, 1), b: New(2024, 2), want: -1},
+		"Before, different year": {a: New(2023, 4), b: New(2024, 1), want: -1},
+		"Equal":                  {a: New(2024, 3), b: New(2024, 3), want: 0},
+		"After":                  {a: New(2025, 1), b: New(2024, 4), want: 1},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := test.a.Compare(test.b); got != test.want {
+				t.Errorf("Compare = %d, want %d", got, test.want)
+			}
+
+			if got := test.a.Before(test.b); got != (test.want < 0) {
+				t.Errorf("Before = %v, want %v", got, test.want < 0)
+			}
+
+			if got := test.a.After(test.b); got != (test.want > 0) {
+				t.Errorf("After = %v, want %v", got, test.want > 0)
+			}
+
+			if got := test.a.Equal(test.b); got != (test.want == 0) {
+				t.Errorf("Equal = %v, want %v", got, test.want == 0)
+			}
+		})
+	}
+}
+
+func TestToTimePeriod(t *testing.T) {
+	t.Parallel()
+
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	tests := map[string]struct {
+		yq        YearQuarter
+		loc       *time.Location
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		"UTC": {
+			yq:        New(2024, 3),
+			loc:       time.UTC,
+			wantStart: time.Date(2024, time.July, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"Q4 ends next year": {
+			yq:        New(2024, 4),
+			loc:       time.UTC,
+			wantStart: time.Date(2024, time.October, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"Quarter with a DST change": {
+			yq:        New(2024, 1),
+			loc:       madrid,
+			wantStart: time.Date(2024, time.January, 1, 0, 0, 0, 0, madrid),
+			wantEnd:   time.Date(2024, time.April, 1, 0, 0, 0, 0, madrid),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := test.yq.ToTimePeriod(test.loc)
+			if !got.StartTime().Equal(test.wantStart) || !got.EndTime().Equal(test.wantEnd) {
+				t.Errorf("ToTimePeriod = [%v, %v), want [%v, %v)",
+					got.StartTime(), got.EndTime(), test.wantStart, test.wantEnd)
+			}
+
+			want := test.wantEnd.Sub(test.wantStart)
+			if got.Duration() != want {
+				t.Errorf("Duration = %v, want %v", got.Duration(), want)
+			}
+		})
+	}
+}
+
+func TestZero(t *testing.T) {
+	t.Parallel()
+
+	var zero YearQuarter
+	if !zero.IsZero() || zero.String() != "0001-Q1" {
+		t.Errorf("zero value = %v, want 0001-Q1", zero)
+	}
+
+	if got := FromTime(time.Date(2024, time.May, 5, 0, 0, 0, 0, time.UTC)); got != New(2024, 2) {
+		t.Errorf("FromTime = %v, want 2024-Q2", got)
+	}
+}